@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/jroimartin/gocui"
+	component "github.com/skanehira/gocui-component"
+)
+
+func main() {
+	gui, err := gocui.NewGui(gocui.Output256)
+
+	if err != nil {
+		panic(err)
+	}
+	defer gui.Close()
+
+	if err := gui.SetKeybinding("", gocui.KeyCtrlC, gocui.ModNone, quit); err != nil {
+		panic(err)
+	}
+
+	manager := component.NewModalManager(gui)
+
+	form := component.NewForm(gui, "Files", 0, 0, 0, 0)
+	name := form.AddInputField("Name", 6, 20).SetText("memo.txt")
+
+	form.AddButton("Rename", func(g *gocui.Gui, v *gocui.View) error {
+		return component.NewPromptDialog(manager, "Input new file name", "Name").
+			SetInputText(name.GetFieldText()).
+			AddValidates(component.Required(), component.MaxLength(20)).
+			OnResult(func(text string, ok bool) error {
+				if !ok {
+					return nil
+				}
+				return component.NewAlertDialog(manager, fmt.Sprintf("Renamed to %s", text)).Show()
+			}).
+			Show()
+	})
+
+	form.AddButton("Delete", func(g *gocui.Gui, v *gocui.View) error {
+		return component.NewConfirmDialog(manager, fmt.Sprintf("Delete %s?", name.GetFieldText())).
+			OnResult(func(ok bool) error {
+				if ok {
+					return component.NewAlertDialog(manager, "Deleted").Show()
+				}
+				return nil
+			}).
+			Show()
+	})
+
+	form.AddButton("Quit", quit)
+
+	if err := form.Draw(); err != nil {
+		panic(err)
+	}
+
+	if err := gui.MainLoop(); err != nil && err != gocui.ErrQuit {
+		panic(err)
+	}
+}
+
+func quit(g *gocui.Gui, v *gocui.View) error {
+	return gocui.ErrQuit
+}
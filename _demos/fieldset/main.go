@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/jroimartin/gocui"
+	component "github.com/skanehira/gocui-component"
+)
+
+func main() {
+	gui, err := gocui.NewGui(gocui.Output256)
+
+	if err != nil {
+		panic(err)
+	}
+	defer gui.Close()
+
+	form := component.NewForm(gui, "Settings", 0, 0, 0, 0)
+	gui.SetManager(form)
+
+	if err := gui.SetKeybinding("", gocui.KeyCtrlC, gocui.ModNone, quit); err != nil {
+		panic(err)
+	}
+
+	form.AddInputField("Name", 9, 20).SetText("server01")
+
+	// Enter or Space on the title collapses the section
+	network := form.AddFieldset("Network")
+	network.AddInputField("Host", 9, 20).SetText("localhost")
+	network.AddInputField("Port", 9, 6).SetText("8080")
+	network.AddCheckBox("Use TLS", 8)
+	network.AddCheckBox("Use proxy", 9)
+	proxy := network.AddInputField("Proxy", 9, 20)
+
+	// proxy is displayed only when it is used
+	form.ShowIf(proxy, func(fd *component.FormData) bool {
+		return fd.GetCheckBoxState("Network.Use proxy")
+	})
+
+	auth := form.AddFieldset("Auth").SetCollapsed(true)
+	auth.AddInputField("User", 9, 20)
+	auth.AddInputField("Password", 9, 20).SetMask()
+
+	// title shows the last change, it is drawn again on every event by SetManager
+	form.OnChange(func(key string, old, new interface{}) {
+		form.SetTitle(fmt.Sprintf("Settings (%s: %v -> %v)", key, old, new))
+	})
+
+	form.AddButton("Save", func(g *gocui.Gui, v *gocui.View) error {
+		fd := form.GetFormData()
+		text := fmt.Sprintf("%s:%s (tls %v)", fd.GetFieldText("Network.Host"),
+			fd.GetFieldText("Network.Port"), fd.GetCheckBoxState("Network.Use TLS"))
+
+		modal := component.NewCenterModal(g, 40).SetText(text)
+		modal.AddButton("OK", gocui.KeyEnter, func(g *gocui.Gui, v *gocui.View) error {
+			if err := modal.Close(); err != nil {
+				return err
+			}
+			form.SetCurrentItem(form.GetCurrentItem())
+			return nil
+		})
+
+		return modal.Draw()
+	})
+	form.AddButton("Quit", quit)
+
+	if err := form.Draw(); err != nil {
+		panic(err)
+	}
+
+	if err := gui.MainLoop(); err != nil && err != gocui.ErrQuit {
+		panic(err)
+	}
+}
+
+func quit(g *gocui.Gui, v *gocui.View) error {
+	return gocui.ErrQuit
+}
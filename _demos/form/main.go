@@ -1,7 +1,10 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jroimartin/gocui"
 	component "github.com/skanehira/gocui-component"
@@ -19,25 +22,52 @@ func main() {
 	}
 	defer gui.Close()
 
-	if err := gui.SetKeybinding("", gocui.KeyCtrlC, gocui.ModNone, quit); err != nil {
-		panic(err)
-	}
-
 	// new form
 	signup := &signup{
-		component.NewForm(gui, "Sign Up", 0, 0, 0, 0),
+		component.NewForm(gui, "Sign Up", 0, 0, 0, 0).
+			SetMinWidth(30).
+			SetMaxWidth(70),
+	}
+
+	// confirm dialog of cancel is displayed on the modals
+	manager := component.NewModalManager(gui)
+	signup.SetModalManager(manager)
+
+	// follow the terminal size
+	gui.SetManager(signup, manager)
+
+	if err := gui.SetKeybinding("", gocui.KeyCtrlC, gocui.ModNone, quit); err != nil {
+		panic(err)
 	}
 
 	// add input field
-	signup.AddInputField("First Name", 11, 18).
+	firstName := signup.AddInputField("First Name", 11, 18).
 		AddValidate("required input", requireValidator)
-	signup.AddInputField("Last Name", 11, 18).
+	lastName := signup.AddInputField("Last Name", 11, 18).
 		AddValidate("required input", requireValidator)
 
+	// names sit side by side, and wrap when the terminal is narrow
+	signup.Group(firstName, lastName)
+	signup.AddInputField("User Name", 11, 18).
+		AddValidates(component.Required()).
+		AddAsyncValidate("already used", userNotExists)
+	signup.AddInputField("Email", 11, 18).
+		AddValidates(component.Required(), component.Email())
+
 	signup.AddInputField("Password", 11, 18).
 		AddValidate("required input", requireValidator).
 		SetMask().
 		SetMaskKeybinding(gocui.KeyCtrlA)
+	signup.AddInputField("Confirm", 11, 18).
+		SetMask()
+
+	signup.AddValidate(func(fd *component.FormData) map[string]error {
+		if fd.GetFieldText("Password") != fd.GetFieldText("Confirm") {
+			return map[string]error{"Confirm": errors.New("password does not match")}
+		}
+		return nil
+	})
+	signup.SetErrorSummary(true)
 
 	// add checkbox
 	signup.AddCheckBox("Age 18+", 11)
@@ -50,54 +80,67 @@ func main() {
 		SetMode(component.VerticalMode).
 		AddOptions("Japan", "America", "China")
 
+	// ctrl+s submits and Esc cancels
+	signup.OnSubmit(signup.regist)
+	signup.OnCancel(func() error {
+		return gocui.ErrQuit
+	})
+
 	// add button
-	signup.AddButton("Regist", signup.regist)
-	signup.AddButton("Cancel", quit)
+	regist := signup.AddButton("Regist", signup.Submit)
+	signup.AddButton("Reset", func(g *gocui.Gui, v *gocui.View) error {
+		return signup.Reset()
+	})
+	signup.AddButton("Cancel", signup.Cancel)
 
-	signup.Draw()
+	// regist is dimmed and skipped until age is confirmed
+	signup.EnableIf(regist, func(fd *component.FormData) bool {
+		return fd.GetCheckBoxState("Age 18+")
+	})
 
-	if err := gui.MainLoop(); err != nil && err != gocui.ErrQuit {
+	if err := signup.Draw(); err != nil {
 		panic(err)
 	}
-}
 
-func (s *signup) regist(g *gocui.Gui, v *gocui.View) error {
-	if !s.Validate() {
-		return nil
+	if err := gui.MainLoop(); err != nil && err != gocui.ErrQuit {
+		panic(err)
 	}
+}
 
+func (s *signup) regist(fd *component.FormData) error {
 	var text string
 
-	for label, ftext := range s.GetFieldTexts() {
-		text += fmt.Sprintf("%s: %s\n", label, ftext)
-	}
-
-	for label, state := range s.GetCheckBoxStates() {
-		text += fmt.Sprintf("%s: %t\n", label, state)
+	for _, field := range fd.Fields() {
+		text += fmt.Sprintf("%s: %v\n", field.Label, field.Value)
 	}
 
-	for label, opt := range s.GetSelectedOpts() {
-		text += fmt.Sprintf("%s: %s\n", label, opt)
-	}
-
-	text += fmt.Sprintf("radio: %s\n", s.GetSelectedRadios())
-
-	modal := component.NewModal(g, 0, 0, 30).SetText(text)
+	modal := component.NewModal(s.Gui, 0, 0, 30).SetText(text)
 	modal.AddButton("OK", gocui.KeyEnter, func(g *gocui.Gui, v *gocui.View) error {
-		modal.Close()
+		if err := modal.Close(); err != nil {
+			return err
+		}
 		s.SetCurrentItem(s.GetCurrentItem())
 		return nil
 	})
 
-	modal.Draw()
-
-	return nil
+	return modal.Draw()
 }
 
 func quit(g *gocui.Gui, v *gocui.View) error {
 	return gocui.ErrQuit
 }
 
+func userNotExists(ctx context.Context, value string) bool {
+	// simulate slow request
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(time.Second):
+	}
+
+	return value != "admin"
+}
+
 func requireValidator(value string) bool {
 	if value == "" {
 		return false
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jroimartin/gocui"
+	component "github.com/skanehira/gocui-component"
+)
+
+type config struct {
+	Host     string        `form:"label=Host,width=20"`
+	Port     int           `form:"width=6"`
+	Password string        `form:"mask"`
+	TLS      bool          `form:"label=Use TLS"`
+	Timeout  time.Duration `form:"width=10"`
+	Mode     string        `form:"options=dev|stg|prd,radio"`
+	Region   string        `form:"options=tokyo|osaka"`
+}
+
+func main() {
+	gui, err := gocui.NewGui(gocui.Output256)
+
+	if err != nil {
+		panic(err)
+	}
+	if err := gui.SetKeybinding("", gocui.KeyCtrlC, gocui.ModNone, quit); err != nil {
+		panic(err)
+	}
+
+	cfg := config{
+		Host:    "localhost",
+		Port:    8080,
+		Timeout: 30 * time.Second,
+	}
+
+	form, err := component.NewFormFromStruct(gui, "Config", &cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	form.AddButton("Save", func(g *gocui.Gui, v *gocui.View) error {
+		if err := form.Bind(&cfg); err != nil {
+			modal := component.NewModal(g, 0, 0, 40).SetText(err.Error())
+			modal.AddButton("OK", gocui.KeyEnter, func(g *gocui.Gui, v *gocui.View) error {
+				if err := modal.Close(); err != nil {
+					return err
+				}
+				form.SetCurrentItem(form.GetCurrentItem())
+				return nil
+			})
+			return modal.Draw()
+		}
+
+		return gocui.ErrQuit
+	})
+	form.AddButton("Cancel", quit)
+
+	if err := form.Draw(); err != nil {
+		panic(err)
+	}
+
+	if err := gui.MainLoop(); err != nil && err != gocui.ErrQuit {
+		panic(err)
+	}
+
+	gui.Close()
+	fmt.Printf("%+v\n", cfg)
+}
+
+func quit(g *gocui.Gui, v *gocui.View) error {
+	return gocui.ErrQuit
+}
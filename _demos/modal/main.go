@@ -1,6 +1,8 @@
 package main
 
 import (
+	"fmt"
+
 	"github.com/jroimartin/gocui"
 	component "github.com/skanehira/gocui-component"
 )
@@ -13,20 +15,39 @@ func main() {
 	}
 	defer gui.Close()
 
+	// keep modals centered when the terminal is resized
+	manager := component.NewModalManager(gui)
+	gui.SetManager(manager)
+
 	if err := gui.SetKeybinding("", gocui.KeyCtrlC, gocui.ModNone, quit); err != nil {
 		panic(err)
 	}
 
-	maxX, maxY := gui.Size()
-	x, y, w := maxX/3, maxY/3, maxX/3*2
+	// long text is scrolled with arrow keys, PgUp/PgDn and Home/End, / searches it
+	text := "Do you want MacBook Pro?"
+	for i := 1; i <= 30; i++ {
+		text += fmt.Sprintf("\nReason %d: it is fast and the display is beautiful.", i)
+	}
 
-	modal := component.NewModal(gui, x, y, w).
-		SetText("Do you want MacBook Pro?")
+	modal := component.NewCenterModal(gui, 40).
+		SetText(text)
 
 	modal.AddButton("No", gocui.KeyEnter, quit)
-	modal.AddButton("Yes", gocui.KeyEnter, quit)
-
-	modal.Draw()
+	modal.AddButton("Yes", gocui.KeyEnter, func(g *gocui.Gui, v *gocui.View) error {
+		// open confirm on top of the modal, Esc or No returns to it
+		confirm := component.NewCenterModal(g, 36).
+			SetText("It is expensive. Are you sure?")
+		confirm.AddButton("No", gocui.KeyEnter, func(g *gocui.Gui, v *gocui.View) error {
+			return confirm.Close()
+		})
+		confirm.AddButton("Yes", gocui.KeyEnter, quit)
+
+		return manager.Push(confirm)
+	})
+
+	if err := manager.Push(modal); err != nil {
+		panic(err)
+	}
 
 	if err := gui.MainLoop(); err != nil && err != gocui.ErrQuit {
 		panic(err)
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/jroimartin/gocui"
+	component "github.com/skanehira/gocui-component"
+)
+
+func main() {
+	gui, err := gocui.NewGui(gocui.Output256)
+
+	if err != nil {
+		panic(err)
+	}
+	defer gui.Close()
+
+	if err := gui.SetKeybinding("", gocui.KeyCtrlC, gocui.ModNone, quit); err != nil {
+		panic(err)
+	}
+
+	table := component.NewTable(gui, "Pods", 0, 0, 50, 10).
+		AddColumn("NAME", 20, component.AlignLeft).
+		AddColumn("STATUS", 10, component.AlignCenter).
+		AddColumn("RESTARTS", 8, component.AlignRight).
+		SetComparator(2, component.CompareNumeric).
+		SetFilterable(8, 20)
+
+	rows := make([][]string, 100000)
+	for i := range rows {
+		rows[i] = []string{fmt.Sprintf("nginx-%d", i), "Running", fmt.Sprint(i % 3)}
+	}
+	table.SetRows(rows)
+
+	table.SetSelectHandler(func(row int, values []string) error {
+		return gocui.ErrQuit
+	})
+
+	table.Draw()
+	table.Focus()
+
+	if err := gui.MainLoop(); err != nil && err != gocui.ErrQuit {
+		panic(err)
+	}
+}
+
+func quit(g *gocui.Gui, v *gocui.View) error {
+	return gocui.ErrQuit
+}
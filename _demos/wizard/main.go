@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/jroimartin/gocui"
+	component "github.com/skanehira/gocui-component"
+)
+
+func main() {
+	gui, err := gocui.NewGui(gocui.Output256)
+
+	if err != nil {
+		panic(err)
+	}
+	defer gui.Close()
+
+	// new wizard
+	wizard := component.NewWizard(gui, "Setup", 0, 0, 40, 0)
+
+	// follow the terminal size
+	gui.SetManager(wizard)
+
+	if err := gui.SetKeybinding("", gocui.KeyCtrlC, gocui.ModNone, quit); err != nil {
+		panic(err)
+	}
+
+	account := wizard.AddStep("Account")
+	account.AddInputField("User Name", 11, 18).
+		AddValidates(component.Required())
+	account.AddInputField("Email", 11, 18).
+		AddValidates(component.Required(), component.Email())
+
+	plan := wizard.AddStep("Plan")
+	plan.AddRadio("Type", 11).
+		SetMode(component.VerticalMode).
+		AddOptions("Free", "Team")
+
+	// team step is displayed only if team plan is selected
+	team := wizard.AddStep("Team").SkipIf(func(fd *component.FormData) bool {
+		return fd.GetSelectedRadio("Plan.Type") != "Team"
+	})
+	team.AddInputField("Team Name", 11, 18).
+		AddValidates(component.Required())
+
+	confirm := wizard.AddStep("Confirm")
+	confirm.AddCheckBox("Agree", 11)
+
+	wizard.OnFinish(func(fd *component.FormData) error {
+		var text string
+		for _, field := range fd.Fields() {
+			text += fmt.Sprintf("%s: %v\n", field.Label, field.Value)
+		}
+
+		modal := component.NewModal(gui, 0, 0, 30).SetText(text)
+		modal.AddButton("OK", gocui.KeyEnter, quit)
+
+		return modal.Draw()
+	})
+
+	if err := wizard.Draw(); err != nil {
+		panic(err)
+	}
+
+	if err := gui.MainLoop(); err != nil && err != gocui.ErrQuit {
+		panic(err)
+	}
+}
+
+func quit(g *gocui.Gui, v *gocui.View) error {
+	return gocui.ErrQuit
+}
@@ -9,9 +9,13 @@ import (
 // Button button struct
 type Button struct {
 	*gocui.Gui
+	id       string
 	label    string
 	handlers Handlers
 	ctype    ComponentType
+	disabled bool
+	dimmed   bool
+	events
 	*Position
 	*Attributes
 }
@@ -24,6 +28,7 @@ func NewButton(gui *gocui.Gui, label string, x, y, width int) *Button {
 
 	b := &Button{
 		Gui:   gui,
+		id:    label,
 		label: label,
 		Position: &Position{
 			x,
@@ -64,6 +69,18 @@ func (b *Button) SetHilightColor(fgColor, bgColor gocui.Attribute) *Button {
 	return b
 }
 
+// SetID set button id which is used as view name
+// it must be called before Draw
+func (b *Button) SetID(id string) *Button {
+	b.id = id
+	return b
+}
+
+// GetID get button id
+func (b *Button) GetID() string {
+	return b.id
+}
+
 // GetLabel get button label
 func (b *Button) GetLabel() string {
 	return b.label
@@ -74,17 +91,56 @@ func (b *Button) GetPosition() *Position {
 	return b.Position
 }
 
+// OnFocus set handler which is called when button gets focus
+func (b *Button) OnFocus(handler func()) *Button {
+	b.onFocus = handler
+	return b
+}
+
+// OnBlur set handler which is called when button loses focus
+func (b *Button) OnBlur(handler func()) *Button {
+	b.onBlur = handler
+	return b
+}
+
 // Focus focus to button
 func (b *Button) Focus() {
 	b.Gui.Cursor = false
-	v, _ := b.Gui.SetCurrentView(b.label)
-	v.Highlight = true
+	if v, err := b.Gui.SetCurrentView(b.id); err == nil {
+		v.Highlight = true
+	}
+	b.emitFocus()
 }
 
 // UnFocus un focus
 func (b *Button) UnFocus() {
-	v, _ := b.Gui.View(b.label)
-	v.Highlight = false
+	if v, err := b.Gui.View(b.id); err == nil {
+		v.Highlight = false
+	}
+	b.emitBlur()
+}
+
+// SetDisabled if b is true, button is dimmed and its handlers are ignored
+func (b *Button) SetDisabled(disabled bool) {
+	b.disabled = disabled
+	b.applyDisabled()
+	b.emitDisabled()
+}
+
+// IsDisabled return disabled state
+func (b *Button) IsDisabled() bool {
+	return b.disabled
+}
+
+// setDimmed dim button while its fieldset is disabled, its own disabled state is kept
+func (b *Button) setDimmed(dimmed bool) {
+	b.dimmed = dimmed
+	b.applyDisabled()
+}
+
+// inactive return true if button is disabled or dimmed
+func (b *Button) inactive() bool {
+	return b.disabled || b.dimmed
 }
 
 // GetType get component type
@@ -93,10 +149,10 @@ func (b *Button) GetType() ComponentType {
 }
 
 // Draw draw button
-func (b *Button) Draw() {
-	if v, err := b.Gui.SetView(b.label, b.X, b.Y, b.W, b.H); err != nil {
+func (b *Button) Draw() error {
+	if v, err := setView(b.Gui, b.id, b.X, b.Y, b.W, b.H); err != nil {
 		if err != gocui.ErrUnknownView {
-			panic(err)
+			return newViewError(b.id, err)
 		}
 
 		v.Frame = false
@@ -108,31 +164,60 @@ func (b *Button) Draw() {
 		v.SelBgColor = b.hilightBgColor
 
 		fmt.Fprint(v, fmt.Sprintf(" %s ", b.label))
-	}
 
-	if b.handlers != nil {
-		for key, handler := range b.handlers {
-			if err := b.Gui.SetKeybinding(b.label, key, gocui.ModNone, handler); err != nil {
-				panic(err)
-			}
+		if err := setKeybindings(b.Gui, b.id, ignoreDisabled(b.handlers, b.inactive)); err != nil {
+			return err
 		}
 	}
 
+	b.applyDisabled()
+
+	return nil
 }
 
 // Close close button
-func (b *Button) Close() {
-	if err := b.DeleteView(b.label); err != nil {
-		if err != gocui.ErrUnknownView {
-			panic(err)
-		}
+func (b *Button) Close() error {
+	b.DeleteKeybindings(b.id)
+	return deleteView(b.Gui, b.id)
+}
+
+// setPosition move button to x, y
+func (b *Button) setPosition(x, y int) {
+	b.Position.move(x-b.X, y-b.Y)
+}
+
+// setLabel change label and width of button
+func (b *Button) setLabel(label string) {
+	b.label = label
+	b.W = b.X + len(label) + 3
+
+	if v, err := b.Gui.View(b.id); err == nil {
+		v.Clear()
+		fmt.Fprint(v, fmt.Sprintf(" %s ", b.label))
 	}
+}
 
-	if b.handlers != nil {
-		b.DeleteKeybindings(b.label)
+// applyDisabled change colors of displayed button by disabled state
+func (b *Button) applyDisabled() {
+	v, err := b.Gui.View(b.id)
+	if err != nil {
+		return
 	}
+
+	v.FgColor = b.textColor
+	v.BgColor = b.textBgColor
+	if b.inactive() {
+		v.FgColor = disabledFieldColor
+		v.BgColor = disabledFieldBgColor
+	}
+}
+
+// getBounds get area of button
+func (b *Button) getBounds() *Position {
+	return &Position{b.X, b.Y, b.W, b.H}
 }
 
+// AddHandlerOnly add handler not return
 func (b *Button) AddHandlerOnly(key Key, handler Handler) {
 	b.handlers[key] = handler
 }
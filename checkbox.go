@@ -9,17 +9,21 @@ import (
 // CheckBox struct
 type CheckBox struct {
 	*gocui.Gui
+	id        string
 	label     string
 	isChecked bool
 	box       *box
 	ctype     ComponentType
 	*Position
 	*Attributes
-	handlers Handlers
+	handlers  Handlers
+	validator *Validator
+	disabled  bool
+	dimmed    bool
+	events
 }
 
 type box struct {
-	name string
 	*Position
 	*Attributes
 }
@@ -38,6 +42,7 @@ func NewCheckBox(gui *gocui.Gui, label string, x, y, labelWidth int) *CheckBox {
 
 	c := &CheckBox{
 		Gui:       gui,
+		id:        label,
 		label:     label,
 		isChecked: false,
 		Position:  p,
@@ -46,7 +51,6 @@ func NewCheckBox(gui *gocui.Gui, label string, x, y, labelWidth int) *CheckBox {
 			textBgColor: gocui.ColorDefault,
 		},
 		box: &box{
-			name: label + "box",
 			Position: &Position{
 				X: p.W,
 				Y: p.Y,
@@ -58,8 +62,9 @@ func NewCheckBox(gui *gocui.Gui, label string, x, y, labelWidth int) *CheckBox {
 				textBgColor: gocui.ColorCyan,
 			},
 		},
-		handlers: make(Handlers),
-		ctype:    TypeCheckBox,
+		handlers:  make(Handlers),
+		validator: NewValidator(gui, viewName(label, "validator"), p.W+3, p.Y, p.W+3, p.H),
+		ctype:     TypeCheckBox,
 	}
 
 	c.handlers[gocui.KeyEnter] = c.Check
@@ -67,6 +72,19 @@ func NewCheckBox(gui *gocui.Gui, label string, x, y, labelWidth int) *CheckBox {
 	return c
 }
 
+// SetID set checkbox id which is used as view name
+// it must be called before Draw
+func (c *CheckBox) SetID(id string) *CheckBox {
+	c.id = id
+	c.validator.name = viewName(id, "validator")
+	return c
+}
+
+// GetID get checkbox id
+func (c *CheckBox) GetID() string {
+	return c.id
+}
+
 // GetLabel get checkbox label
 func (c *CheckBox) GetLabel() string {
 	return c.label
@@ -87,7 +105,9 @@ func (c *CheckBox) Check(g *gocui.Gui, v *gocui.View) error {
 		c.isChecked = true
 	}
 
-	return nil
+	c.validator.Validate("")
+
+	return c.emitChange(!c.isChecked, c.isChecked)
 }
 
 // AddHandler add handler
@@ -96,6 +116,25 @@ func (c *CheckBox) AddHandler(key Key, handler Handler) *CheckBox {
 	return c
 }
 
+// AddValidate add checkbox validator
+func (c *CheckBox) AddValidate(errMsg string, validate func(checked bool) bool) *CheckBox {
+	c.validator.AddValidate(errMsg, func(string) bool {
+		return validate(c.isChecked)
+	})
+	return c
+}
+
+// Validate validate checkbox state
+func (c *CheckBox) Validate() bool {
+	c.validator.Validate("")
+	return c.validator.IsValid()
+}
+
+// IsValid valid state will be return true
+func (c *CheckBox) IsValid() bool {
+	return c.validator.IsValid()
+}
+
 // AddAttribute add text and bg color
 func (c *CheckBox) AddAttribute(textColor, textBgColor gocui.Attribute) *CheckBox {
 	c.Attributes = &Attributes{
@@ -106,20 +145,94 @@ func (c *CheckBox) AddAttribute(textColor, textBgColor gocui.Attribute) *CheckBo
 	return c
 }
 
+// SetDisabled if b is true, checkbox is dimmed and its handlers are ignored
+func (c *CheckBox) SetDisabled(b bool) {
+	c.disabled = b
+	c.applyDisabled()
+	c.emitDisabled()
+}
+
+// IsDisabled return disabled state
+func (c *CheckBox) IsDisabled() bool {
+	return c.disabled
+}
+
+// setDimmed dim checkbox while its fieldset is disabled, its own disabled state is kept
+func (c *CheckBox) setDimmed(b bool) {
+	c.dimmed = b
+	c.applyDisabled()
+}
+
+// inactive return true if checkbox is disabled or dimmed
+func (c *CheckBox) inactive() bool {
+	return c.disabled || c.dimmed
+}
+
+// SetValue set check state, value must be bool
+// change handler is called if state is changed
+func (c *CheckBox) SetValue(value interface{}) error {
+	checked, ok := value.(bool)
+	if !ok {
+		return invalidValue(c.id, value)
+	}
+	if checked == c.isChecked {
+		return nil
+	}
+
+	c.isChecked = checked
+	if v, err := c.Gui.View(c.id); err == nil {
+		v.Clear()
+		if checked {
+			fmt.Fprint(v, "X")
+		}
+	}
+
+	if !c.IsValid() {
+		c.validator.Validate("")
+	}
+
+	return c.emitChange(!checked, checked)
+}
+
+// GetValue get check state
+func (c *CheckBox) GetValue() interface{} {
+	return c.isChecked
+}
+
 // IsChecked return check state
 func (c *CheckBox) IsChecked() bool {
 	return c.isChecked
 }
 
+// OnChange set handler which is called when checkbox is checked or unchecked
+func (c *CheckBox) OnChange(handler func(old, new interface{})) *CheckBox {
+	c.onChange = handler
+	return c
+}
+
+// OnFocus set handler which is called when checkbox gets focus
+func (c *CheckBox) OnFocus(handler func()) *CheckBox {
+	c.onFocus = handler
+	return c
+}
+
+// OnBlur set handler which is called when checkbox loses focus
+func (c *CheckBox) OnBlur(handler func()) *CheckBox {
+	c.onBlur = handler
+	return c
+}
+
 // Focus focus to checkbox
 func (c *CheckBox) Focus() {
 	c.Gui.Cursor = true
-	c.Gui.SetCurrentView(c.box.name)
+	c.Gui.SetCurrentView(c.id)
+	c.emitFocus()
 }
 
 // UnFocus unfocus
 func (c *CheckBox) UnFocus() {
 	c.Gui.Cursor = false
+	c.emitBlur()
 }
 
 // GetType get component type
@@ -128,11 +241,12 @@ func (c *CheckBox) GetType() ComponentType {
 }
 
 // Draw draw label and checkbox
-func (c *CheckBox) Draw() {
+func (c *CheckBox) Draw() error {
 	// draw label
-	if v, err := c.Gui.SetView(c.label, c.X, c.Y, c.W, c.H); err != nil {
+	name := viewName(c.id, "label")
+	if v, err := setView(c.Gui, name, c.X, c.Y, c.W, c.H); err != nil {
 		if err != gocui.ErrUnknownView {
-			panic(err)
+			return newViewError(name, err)
 		}
 
 		v.Frame = false
@@ -143,41 +257,83 @@ func (c *CheckBox) Draw() {
 
 	// draw checkbox
 	b := c.box
-	if v, err := c.Gui.SetView(b.name, b.X, b.Y, b.W, b.H); err != nil {
+	if v, err := setView(c.Gui, c.id, b.X, b.Y, b.W, b.H); err != nil {
 		if err != gocui.ErrUnknownView {
-			panic(err)
+			return newViewError(c.id, err)
 		}
 
 		v.Frame = false
 		v.FgColor = b.textColor
 		v.BgColor = b.textBgColor
 
+		if c.isChecked {
+			fmt.Fprint(v, "X")
+		}
+
 		c.Gui.SetCurrentView(v.Name())
 
-		for key, handler := range c.handlers {
-			if err := c.Gui.SetKeybinding(v.Name(), key, gocui.ModNone, handler); err != nil {
-				panic(err)
-			}
+		if err := setKeybindings(c.Gui, v.Name(), ignoreDisabled(c.handlers, c.inactive)); err != nil {
+			return err
 		}
 	}
+
+	c.applyDisabled()
+
+	return nil
 }
 
 // Close close checkbox
-func (c *CheckBox) Close() {
+func (c *CheckBox) Close() error {
 	views := []string{
-		c.label,
-		c.box.name,
+		viewName(c.id, "label"),
+		c.id,
 	}
 
 	for _, v := range views {
-		if err := c.DeleteView(v); err != nil {
-			if err != gocui.ErrUnknownView {
-				panic(err)
-			}
+		if err := deleteView(c.Gui, v); err != nil {
+			return err
+		}
+	}
+
+	c.DeleteKeybindings(c.id)
+
+	return c.validator.CloseValidateMsg()
+}
+
+func (c *CheckBox) getValidator() *Validator {
+	return c.validator
+}
+
+// setPosition move label, box and error message to x, y
+func (c *CheckBox) setPosition(x, y int) {
+	dx, dy := x-c.X, y-c.Y
+	c.Position.move(dx, dy)
+	c.box.Position.move(dx, dy)
+	c.validator.Position.move(dx, dy)
+}
+
+// getBounds get area of label and box
+func (c *CheckBox) getBounds() *Position {
+	return &Position{c.X, c.Y, c.box.W, c.H}
+}
+
+// applyDisabled change colors of displayed label and box by disabled state
+func (c *CheckBox) applyDisabled() {
+	if v, err := c.Gui.View(viewName(c.id, "label")); err == nil {
+		v.FgColor = c.textColor
+		if c.inactive() {
+			v.FgColor = disabledTextColor
 		}
 	}
 
-	c.DeleteKeybindings(c.box.name)
+	if v, err := c.Gui.View(c.id); err == nil {
+		v.FgColor = c.box.textColor
+		v.BgColor = c.box.textBgColor
+		if c.inactive() {
+			v.FgColor = disabledFieldColor
+			v.BgColor = disabledFieldBgColor
+		}
+	}
 }
 
 // AddHandlerOnly add handler not retrun
@@ -0,0 +1,275 @@
+package component
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jroimartin/gocui"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+const dialogMinWidth = 30
+
+// ConfirmDialog yes or no dialog
+type ConfirmDialog struct {
+	*Modal
+	modals   *ModalManager
+	done     bool
+	onResult func(ok bool) error
+	result   chan bool
+}
+
+// AlertDialog dialog which only has OK button
+type AlertDialog struct {
+	*Modal
+	modals  *ModalManager
+	done    bool
+	onClose func() error
+	result  chan struct{}
+}
+
+// PromptDialog dialog which has input field
+type PromptDialog struct {
+	*Modal
+	modals   *ModalManager
+	done     bool
+	onResult func(text string, ok bool) error
+	result   chan PromptResult
+}
+
+// PromptResult result of prompt dialog
+// OK is false if the dialog is canceled
+type PromptResult struct {
+	Text string
+	OK   bool
+}
+
+// NewConfirmDialog new confirm dialog
+// y or Yes button is ok, n, Esc or No button is cancel
+// No button is focused first, so that Enter does not confirm by mistake
+func NewConfirmDialog(manager *ModalManager, text string) *ConfirmDialog {
+	d := &ConfirmDialog{
+		Modal:  NewCenterModal(manager.Gui, dialogWidth(text)).SetText(text),
+		result: make(chan bool, 1),
+		modals: manager,
+	}
+
+	no := d.AddButton("No", gocui.KeyEnter, d.no)
+	d.AddButton("Yes", gocui.KeyEnter, d.yes)
+	d.SetDefaultButton(no)
+
+	for _, b := range d.buttons {
+		b.AddHandler('y', d.yes).
+			AddHandler('Y', d.yes).
+			AddHandler('n', d.no).
+			AddHandler('N', d.no).
+			AddHandler(gocui.KeyEsc, d.no)
+	}
+
+	return d
+}
+
+// OnResult set function which is called with the answer
+func (d *ConfirmDialog) OnResult(f func(ok bool) error) *ConfirmDialog {
+	d.onResult = f
+	return d
+}
+
+// Result get channel which receives the answer
+func (d *ConfirmDialog) Result() <-chan bool {
+	return d.result
+}
+
+// Show show dialog
+func (d *ConfirmDialog) Show() error {
+	return d.modals.Push(d.Modal)
+}
+
+func (d *ConfirmDialog) yes(g *gocui.Gui, v *gocui.View) error {
+	return d.finish(true)
+}
+
+func (d *ConfirmDialog) no(g *gocui.Gui, v *gocui.View) error {
+	return d.finish(false)
+}
+
+func (d *ConfirmDialog) finish(ok bool) error {
+	if d.done {
+		return nil
+	}
+	d.done = true
+
+	if err := d.Close(); err != nil {
+		return err
+	}
+
+	d.result <- ok
+
+	if d.onResult != nil {
+		return d.onResult(ok)
+	}
+
+	return nil
+}
+
+// NewAlertDialog new alert dialog
+// Enter or Esc closes the dialog
+func NewAlertDialog(manager *ModalManager, text string) *AlertDialog {
+	d := &AlertDialog{
+		Modal:  NewCenterModal(manager.Gui, dialogWidth(text)).SetText(text),
+		result: make(chan struct{}, 1),
+		modals: manager,
+	}
+
+	d.AddButton("OK", gocui.KeyEnter, d.close).
+		AddHandler(gocui.KeyEsc, d.close)
+
+	return d
+}
+
+// OnClose set function which is called when the dialog is closed
+func (d *AlertDialog) OnClose(f func() error) *AlertDialog {
+	d.onClose = f
+	return d
+}
+
+// Done get channel which is closed when the dialog is closed
+func (d *AlertDialog) Done() <-chan struct{} {
+	return d.result
+}
+
+// Show show dialog
+func (d *AlertDialog) Show() error {
+	return d.modals.Push(d.Modal)
+}
+
+func (d *AlertDialog) close(g *gocui.Gui, v *gocui.View) error {
+	if d.done {
+		return nil
+	}
+	d.done = true
+
+	if err := d.Close(); err != nil {
+		return err
+	}
+
+	close(d.result)
+
+	if d.onClose != nil {
+		return d.onClose()
+	}
+
+	return nil
+}
+
+// NewPromptDialog new prompt dialog
+// Enter submits the input if it is valid, Esc or Cancel button is cancel
+func NewPromptDialog(manager *ModalManager, text, label string) *PromptDialog {
+	width := dialogWidth(text)
+
+	d := &PromptDialog{
+		Modal:  NewCenterModal(manager.Gui, width).SetText(text),
+		result: make(chan PromptResult, 1),
+		modals: manager,
+	}
+
+	labelWidth := len(label) + 1
+	d.AddInputField(label, labelWidth, width-labelWidth-6).
+		AddHandler(gocui.KeyEnter, d.submit).
+		AddHandler(gocui.KeyEsc, d.cancel)
+
+	d.AddButton("Cancel", gocui.KeyEnter, d.cancel).
+		AddHandler(gocui.KeyEsc, d.cancel)
+	d.AddButton("OK", gocui.KeyEnter, d.submit).
+		AddHandler(gocui.KeyEsc, d.cancel)
+
+	return d
+}
+
+// SetInputText set default input text
+func (d *PromptDialog) SetInputText(text string) *PromptDialog {
+	d.input.SetText(text)
+	return d
+}
+
+// SetMask mask input text
+func (d *PromptDialog) SetMask() *PromptDialog {
+	d.input.SetMask()
+	return d
+}
+
+// AddValidate add input validator
+func (d *PromptDialog) AddValidate(errMsg string, validate func(value string) bool) *PromptDialog {
+	d.input.AddValidate(errMsg, validate)
+	return d
+}
+
+// AddValidates add input validates such as Required()
+func (d *PromptDialog) AddValidates(validates ...Validate) *PromptDialog {
+	d.input.AddValidates(validates...)
+	return d
+}
+
+// AddAsyncValidate add validate which takes a long time
+func (d *PromptDialog) AddAsyncValidate(errMsg string, validate func(ctx context.Context, value string) bool) *PromptDialog {
+	d.input.AddAsyncValidate(errMsg, validate)
+	return d
+}
+
+// OnResult set function which is called with the input
+func (d *PromptDialog) OnResult(f func(text string, ok bool) error) *PromptDialog {
+	d.onResult = f
+	return d
+}
+
+// Result get channel which receives the input
+func (d *PromptDialog) Result() <-chan PromptResult {
+	return d.result
+}
+
+// Show show dialog
+func (d *PromptDialog) Show() error {
+	return d.modals.Push(d.Modal)
+}
+
+func (d *PromptDialog) submit(g *gocui.Gui, v *gocui.View) error {
+	if !d.input.Validate() {
+		return nil
+	}
+	return d.finish(d.input.GetFieldText(), true)
+}
+
+func (d *PromptDialog) cancel(g *gocui.Gui, v *gocui.View) error {
+	return d.finish("", false)
+}
+
+func (d *PromptDialog) finish(text string, ok bool) error {
+	if d.done {
+		return nil
+	}
+	d.done = true
+
+	if err := d.Close(); err != nil {
+		return err
+	}
+
+	d.result <- PromptResult{Text: text, OK: ok}
+
+	if d.onResult != nil {
+		return d.onResult(text, ok)
+	}
+
+	return nil
+}
+
+// dialogWidth get width of dialog which fits the longest line of text
+func dialogWidth(text string) int {
+	width := dialogMinWidth
+	for _, line := range strings.Split(text, "\n") {
+		if n := runewidth.StringWidth(line) + 4; n > width {
+			width = n
+		}
+	}
+
+	return width
+}
@@ -0,0 +1,30 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/jroimartin/gocui"
+)
+
+func TestConfirmDialogFocusesNo(t *testing.T) {
+	d := NewConfirmDialog(NewModalManager(&gocui.Gui{}), "Discard changes?")
+
+	if got := d.buttons[d.defaultButton()].GetLabel(); got != "No" {
+		t.Errorf("default button = %q, want No", got)
+	}
+}
+
+func TestModalDefaultButton(t *testing.T) {
+	m := NewModal(&gocui.Gui{}, 0, 0, 30)
+	ok := m.AddButton("OK", gocui.KeyEnter, nil)
+	m.AddButton("Cancel", gocui.KeyEnter, nil)
+
+	if got := m.buttons[m.defaultButton()].GetLabel(); got != "Cancel" {
+		t.Errorf("default button = %q, want last button Cancel", got)
+	}
+
+	m.SetDefaultButton(ok)
+	if got := m.buttons[m.defaultButton()].GetLabel(); got != "OK" {
+		t.Errorf("default button = %q, want OK", got)
+	}
+}
@@ -0,0 +1,191 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jroimartin/gocui"
+)
+
+var (
+	// ErrInvalidDimensions view cannot be drawn because its width or height is not positive
+	// it happens when terminal is too small for the component
+	ErrInvalidDimensions = errors.New("invalid dimensions")
+	// ErrInvalidKey key type is not gocui.Key or rune
+	ErrInvalidKey = errors.New("invalid key")
+	// ErrNoOptions select or radio has no options
+	ErrNoOptions = errors.New("no options")
+	// ErrNoSteps wizard has no steps to display
+	ErrNoSteps = errors.New("no steps")
+	// ErrInvalidValue value has wrong type or is not in options
+	ErrInvalidValue = errors.New("invalid value")
+	// ErrNoModalManager form cannot confirm cancel because modal manager is not set
+	ErrNoModalManager = errors.New("no modal manager")
+)
+
+// ViewError error of gocui view operation
+type ViewError struct {
+	View string
+	Err  error
+}
+
+// Error return error message
+func (e *ViewError) Error() string {
+	return fmt.Sprintf("view %q: %s", e.View, e.Err)
+}
+
+// Unwrap return original error
+func (e *ViewError) Unwrap() error {
+	return e.Err
+}
+
+// newViewError convert gocui error to ViewError
+func newViewError(view string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := err.(*ViewError); ok {
+		return err
+	}
+
+	return &ViewError{
+		View: view,
+		Err:  err,
+	}
+}
+
+// setView set view like gocui.SetView
+// size is checked before gocui, because gocui does not export its error of invalid dimensions
+func setView(g *gocui.Gui, name string, x0, y0, x1, y1 int) (*gocui.View, error) {
+	if x0 >= x1 || y0 >= y1 {
+		return nil, &ViewError{
+			View: name,
+			Err:  ErrInvalidDimensions,
+		}
+	}
+
+	return g.SetView(name, x0, y0, x1, y1)
+}
+
+// invalidValue error of value which cannot be set to component
+func invalidValue(id string, value interface{}) error {
+	return &ViewError{
+		View: id,
+		Err:  fmt.Errorf("%w: %v", ErrInvalidValue, value),
+	}
+}
+
+// deleteView delete view, unknown view is not error
+func deleteView(g *gocui.Gui, name string) error {
+	if err := g.DeleteView(name); err != nil && err != gocui.ErrUnknownView {
+		return newViewError(name, err)
+	}
+	return nil
+}
+
+// sendError return err from main loop of gui
+// it is used where error cannot be returned such as editor
+func sendError(g *gocui.Gui, err error) {
+	if err == nil {
+		return
+	}
+
+	g.Update(func(*gocui.Gui) error {
+		return err
+	})
+}
+
+// setKeybindings set handlers to view
+func setKeybindings(g *gocui.Gui, name string, handlers Handlers) error {
+	for key, handler := range handlers {
+		if err := g.SetKeybinding(name, key, gocui.ModNone, handler); err != nil {
+			return &ViewError{
+				View: name,
+				Err:  fmt.Errorf("%w: %v", ErrInvalidKey, key),
+			}
+		}
+	}
+	return nil
+}
+
+// Must panic if err is not nil
+// it keeps the behavior before Draw and Close returned error
+//
+//	component.Must(form.Draw())
+func Must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// LegacyComponent component interface which Draw and Close do not return error
+type LegacyComponent interface {
+	GetLabel() string
+	GetPosition() *Position
+	GetType() ComponentType
+	Focus()
+	UnFocus()
+	Draw()
+	Close()
+	AddHandlerOnly(Key, Handler)
+}
+
+// legacyComponent adapter of LegacyComponent
+type legacyComponent struct {
+	LegacyComponent
+	disabled bool
+}
+
+// WrapLegacy convert LegacyComponent to Component
+// panic in Draw and Close is returned as error
+func WrapLegacy(c LegacyComponent) Component {
+	return &legacyComponent{LegacyComponent: c}
+}
+
+// GetID get component id, it is the same as label
+func (l *legacyComponent) GetID() string {
+	return l.GetLabel()
+}
+
+// Draw draw component
+func (l *legacyComponent) Draw() (err error) {
+	defer func() {
+		err = recoverError(recover())
+	}()
+
+	l.LegacyComponent.Draw()
+	return nil
+}
+
+// Close close component
+func (l *legacyComponent) Close() (err error) {
+	defer func() {
+		err = recoverError(recover())
+	}()
+
+	l.LegacyComponent.Close()
+	return nil
+}
+
+// SetDisabled set disabled state
+// legacy component is not dimmed, it is only skipped by form
+func (l *legacyComponent) SetDisabled(b bool) {
+	l.disabled = b
+}
+
+// IsDisabled return disabled state
+func (l *legacyComponent) IsDisabled() bool {
+	return l.disabled
+}
+
+func recoverError(r interface{}) error {
+	switch r := r.(type) {
+	case nil:
+		return nil
+	case error:
+		return r
+	default:
+		return fmt.Errorf("%v", r)
+	}
+}
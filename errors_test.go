@@ -0,0 +1,31 @@
+package component
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jroimartin/gocui"
+)
+
+func TestSetViewInvalidDimensions(t *testing.T) {
+	tests := []struct {
+		x0, y0, x1, y1 int
+	}{
+		{0, 0, 0, 5},
+		{0, 0, 5, 0},
+		{10, 0, 5, 5},
+		{0, 3, 5, -1},
+	}
+
+	for _, tt := range tests {
+		_, err := setView(&gocui.Gui{}, "view", tt.x0, tt.y0, tt.x1, tt.y1)
+		if !errors.Is(err, ErrInvalidDimensions) {
+			t.Errorf("setView(%d, %d, %d, %d) error = %v, want ErrInvalidDimensions", tt.x0, tt.y0, tt.x1, tt.y1, err)
+		}
+
+		var ve *ViewError
+		if errors.As(newViewError("other", err), &ve) && ve.View != "view" {
+			t.Errorf("newViewError wrapped ViewError again with view %q", ve.View)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package component
+
+// events handlers which are called when component value or focus is changed
+type events struct {
+	onChange      func(old, new interface{})
+	onFocus       func()
+	onBlur        func()
+	notify        func(old, new interface{}) error
+	disableNotify func()
+	hasFocus      bool
+}
+
+// setNotify set function which tells form that value is changed
+func (e *events) setNotify(notify func(old, new interface{}) error) {
+	e.notify = notify
+}
+
+// setDisableNotify set function which tells form that disabled state is changed
+func (e *events) setDisableNotify(notify func()) {
+	e.disableNotify = notify
+}
+
+// emitDisabled tell form that disabled state is changed
+func (e *events) emitDisabled() {
+	if e.disableNotify != nil {
+		e.disableNotify()
+	}
+}
+
+// emitChange call change handler and tell form
+func (e *events) emitChange(old, new interface{}) error {
+	if e.onChange != nil {
+		e.onChange(old, new)
+	}
+	if e.notify != nil {
+		return e.notify(old, new)
+	}
+	return nil
+}
+
+// emitFocus call focus handler when component gets focus
+// moving focus in the component does not call it again
+func (e *events) emitFocus() {
+	if e.hasFocus {
+		return
+	}
+	e.hasFocus = true
+	if e.onFocus != nil {
+		e.onFocus()
+	}
+}
+
+// emitBlur call blur handler when component loses focus
+func (e *events) emitBlur() {
+	if !e.hasFocus {
+		return
+	}
+	e.hasFocus = false
+	if e.onBlur != nil {
+		e.onBlur()
+	}
+}
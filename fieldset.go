@@ -0,0 +1,360 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/jroimartin/gocui"
+)
+
+const (
+	expandedMark  = "▼"
+	collapsedMark = "▶"
+)
+
+// Fieldset titled frame which holds components in form
+type Fieldset struct {
+	*gocui.Gui
+	form         *Form
+	id           string
+	title        string
+	items        []Component
+	collapsed    bool
+	disabled     bool
+	focused      bool
+	limit        int
+	clipTop      int
+	clipBottom   int
+	handlers     Handlers
+	collapseKeys []Key
+	ctype        ComponentType
+	events
+	*Position
+}
+
+// AddFieldset add fieldset to form
+// components in fieldset are navigated as part of form,
+// and their values are got with keys qualified with the title such as "Network.Host"
+func (f *Form) AddFieldset(title string) *Fieldset {
+	fs := &Fieldset{
+		Gui:          f.Gui,
+		form:         f,
+		id:           f.newID(title),
+		title:        title,
+		limit:        noLimit,
+		handlers:     make(Handlers),
+		collapseKeys: []Key{gocui.KeyEnter, gocui.KeySpace},
+		ctype:        TypeFieldset,
+		Position: &Position{
+			X: f.X + 1,
+			Y: f.Y,
+		},
+	}
+	fs.layout()
+
+	f.components = append(f.components, fs)
+
+	return fs
+}
+
+// AddInputField add input field to fieldset
+func (fs *Fieldset) AddInputField(label string, labelWidth, fieldWidth int) *InputField {
+	input := fs.form.AddInputField(label, labelWidth, fieldWidth)
+	fs.adopt(input.SetID(fs.newID(label)))
+	return input
+}
+
+// AddButton add button to fieldset
+func (fs *Fieldset) AddButton(label string, handler Handler) *Button {
+	button := fs.form.AddButton(label, handler)
+	fs.adopt(button.SetID(fs.newID(label)))
+	return button
+}
+
+// AddCheckBox add checkbox to fieldset
+func (fs *Fieldset) AddCheckBox(label string, width int) *CheckBox {
+	checkbox := fs.form.AddCheckBox(label, width)
+	fs.adopt(checkbox.SetID(fs.newID(label)))
+	return checkbox
+}
+
+// AddSelect add select to fieldset
+func (fs *Fieldset) AddSelect(label string, labelWidth, listWidth int) *Select {
+	Select := fs.form.AddSelect(label, labelWidth, listWidth)
+	fs.adopt(Select.SetID(fs.newID(label)))
+	return Select
+}
+
+// AddRadio add radio to fieldset
+func (fs *Fieldset) AddRadio(label string, width int) *Radio {
+	radio := fs.form.AddRadio(label, width)
+	fs.adopt(radio.SetID(fs.newID(label)))
+	return radio
+}
+
+// AddTable add table to fieldset
+func (fs *Fieldset) AddTable(label string, width, height int) *Table {
+	table := fs.form.AddTable(label, width, height)
+	fs.adopt(table.SetID(fs.newID(label)))
+	return table
+}
+
+// AddTextArea add text area to fieldset
+func (fs *Fieldset) AddTextArea(label string, labelWidth, fieldWidth, height int) *TextArea {
+	textArea := fs.form.AddTextArea(label, labelWidth, fieldWidth, height)
+	fs.adopt(textArea.SetID(fs.newID(label)))
+	return textArea
+}
+
+// SetCollapsed if b is true, components in fieldset are hidden
+// call it before Draw, use Toggle after that
+func (fs *Fieldset) SetCollapsed(b bool) *Fieldset {
+	fs.collapsed = b
+	fs.layout()
+	return fs
+}
+
+// SetCollapseKey set keys which collapse and expand fieldset
+// default keys are Enter and Space
+func (fs *Fieldset) SetCollapseKey(keys ...Key) *Fieldset {
+	fs.collapseKeys = keys
+	return fs
+}
+
+// IsCollapsed return collapse state
+func (fs *Fieldset) IsCollapsed() bool {
+	return fs.collapsed
+}
+
+// Toggle collapse or expand fieldset and move components under it
+func (fs *Fieldset) Toggle() error {
+	fs.collapsed = !fs.collapsed
+
+	// components in collapsed fieldset are closed by form
+	if _, err := fs.Gui.View(fs.form.name); err != nil {
+		fs.layout()
+		return nil
+	}
+
+	fs.form.layout()
+	return fs.form.draw()
+}
+
+// SetDisabled disable fieldset, components in it are dimmed and skipped by form
+// their own disabled state is kept, and disabled fieldset cannot be collapsed or expanded
+func (fs *Fieldset) SetDisabled(b bool) {
+	fs.disabled = b
+	for _, c := range fs.items {
+		fs.form.dim(c)
+	}
+	fs.emitDisabled()
+}
+
+// IsDisabled return disabled state
+func (fs *Fieldset) IsDisabled() bool {
+	return fs.disabled
+}
+
+// GetItems get components in fieldset
+func (fs *Fieldset) GetItems() []Component {
+	return fs.items
+}
+
+// GetID get fieldset id
+func (fs *Fieldset) GetID() string {
+	return fs.id
+}
+
+// GetLabel get fieldset title
+func (fs *Fieldset) GetLabel() string {
+	return fs.title
+}
+
+// GetPosition get fieldset position
+func (fs *Fieldset) GetPosition() *Position {
+	return fs.Position
+}
+
+// GetType get component type
+func (fs *Fieldset) GetType() ComponentType {
+	return fs.ctype
+}
+
+// OnFocus set handler which is called when fieldset title gets focus
+func (fs *Fieldset) OnFocus(handler func()) *Fieldset {
+	fs.onFocus = handler
+	return fs
+}
+
+// OnBlur set handler which is called when fieldset title loses focus
+func (fs *Fieldset) OnBlur(handler func()) *Fieldset {
+	fs.onBlur = handler
+	return fs
+}
+
+// Focus focus to fieldset title
+func (fs *Fieldset) Focus() {
+	fs.focused = true
+	fs.Gui.Cursor = false
+	if v, err := fs.Gui.SetCurrentView(fs.id); err == nil {
+		v.Title = fs.titleText()
+	}
+	fs.emitFocus()
+}
+
+// UnFocus un focus
+func (fs *Fieldset) UnFocus() {
+	fs.focused = false
+	if v, err := fs.Gui.View(fs.id); err == nil {
+		v.Title = fs.titleText()
+	}
+	fs.emitBlur()
+}
+
+// Draw draw fieldset frame
+// components in fieldset are drawn by form
+func (fs *Fieldset) Draw() error {
+	y0, y1 := fs.clip()
+	v, err := setView(fs.Gui, fs.id, fs.X, y0, fs.W, y1)
+	if err != nil {
+		if err != gocui.ErrUnknownView {
+			return newViewError(fs.id, err)
+		}
+
+		v.Frame = true
+
+		handlers := make(Handlers)
+		for key, handler := range fs.handlers {
+			handlers[key] = handler
+		}
+		for _, key := range fs.collapseKeys {
+			handlers[key] = fs.toggle
+		}
+
+		if err := setKeybindings(fs.Gui, fs.id, ignoreDisabled(handlers, fs.IsDisabled)); err != nil {
+			return err
+		}
+	}
+
+	v.Title = fs.titleText()
+
+	return nil
+}
+
+// Close close fieldset frame
+func (fs *Fieldset) Close() error {
+	fs.DeleteKeybindings(fs.id)
+	return deleteView(fs.Gui, fs.id)
+}
+
+// AddHandlerOnly add handler not return
+// collapse keys are not overwritten
+func (fs *Fieldset) AddHandlerOnly(key Key, handler Handler) {
+	for _, k := range fs.collapseKeys {
+		if k == key {
+			return
+		}
+	}
+
+	fs.handlers[key] = handler
+}
+
+// setPosition move fieldset and its components to x, y
+func (fs *Fieldset) setPosition(x, y int) {
+	fs.X, fs.Y = x, y
+	fs.layout()
+}
+
+// getBounds get area of fieldset frame
+func (fs *Fieldset) getBounds() *Position {
+	return &Position{fs.X, fs.Y, fs.W, fs.H}
+}
+
+// setWidth stretch fieldset to width, components in it are shrunk to fit
+func (fs *Fieldset) setWidth(width int) {
+	fs.limit = width
+	fs.layout()
+}
+
+// layout place components in fieldset from top to bottom
+// collapsed fieldset has only its frame
+func (fs *Fieldset) layout() {
+	// corners, brackets, mark and spaces around title
+	minW := fs.X + len(fs.title) + 6
+
+	if fs.collapsed {
+		fs.W = minW
+		fs.H = fs.Y + 1
+	} else {
+		right := noLimit
+		if fs.limit != noLimit {
+			right = fs.X + fs.limit - 1
+		}
+
+		// blank line under components is used by error message
+		w, h := fs.form.stack(fs.form.unitsOf(fs.items, true), fs.X+1, fs.Y, right)
+		fs.W = w + 1
+		fs.H = h + 1
+	}
+
+	if fs.limit != noLimit {
+		fs.W = fs.X + fs.limit
+	}
+	if fs.W < minW {
+		fs.W = minW
+	}
+}
+
+// setClip set rows which fieldset frame is drawn in, such as visible area of scrolled form
+func (fs *Fieldset) setClip(top, bottom int) {
+	fs.clipTop = top
+	fs.clipBottom = bottom
+}
+
+// clip get top and bottom of fieldset frame in visible area
+func (fs *Fieldset) clip() (int, int) {
+	y0, y1 := fs.Y, fs.H
+	if fs.clipBottom > fs.clipTop {
+		if y0 < fs.clipTop {
+			y0 = fs.clipTop
+		}
+		if y1 > fs.clipBottom {
+			y1 = fs.clipBottom
+		}
+	}
+	return y0, y1
+}
+
+func (fs *Fieldset) toggle(g *gocui.Gui, v *gocui.View) error {
+	return fs.Toggle()
+}
+
+// titleText get title with collapse state, it is bracketed when focused
+func (fs *Fieldset) titleText() string {
+	mark := expandedMark
+	if fs.collapsed {
+		mark = collapsedMark
+	}
+
+	if fs.focused {
+		return fmt.Sprintf("[%s %s]", mark, fs.title)
+	}
+	return fmt.Sprintf(" %s %s ", mark, fs.title)
+}
+
+// adopt add component which is added to form to fieldset
+func (fs *Fieldset) adopt(c Component) {
+	if fs.form.sections == nil {
+		fs.form.sections = make(map[Component]*Fieldset)
+	}
+	fs.form.sections[c] = fs
+	fs.items = append(fs.items, c)
+}
+
+// newID get unique id of component in fieldset
+func (fs *Fieldset) newID(label string) string {
+	id := scopedID(fs.id, label)
+	for n := 2; fs.form.ComponentByID(id) != nil; n++ {
+		id = fmt.Sprintf("%s#%d", scopedID(fs.id, label), n)
+	}
+	return id
+}
@@ -1,39 +1,68 @@
 package component
 
 import (
+	"fmt"
+	"reflect"
+
 	"github.com/jroimartin/gocui"
 )
 
 // Form form struct
 type Form struct {
 	*gocui.Gui
-	activeItem  int
-	activeRadio int
-	name        string
-	inputs      []*InputField
-	checkBoxs   []*CheckBox
-	buttons     []*Button
-	selects     []*Select
-	radios      []*Radio
-	components  []Component
-	closeFuncs  []func() error
+	activeItem   int
+	activeRadio  int
+	name         string
+	title        string
+	inputs       []*InputField
+	checkBoxs    []*CheckBox
+	buttons      []*Button
+	selects      []*Select
+	radios       []*Radio
+	tables       []*Table
+	textAreas    []*TextArea
+	components   []Component
+	closeFuncs   []func() error
+	bindType     reflect.Type
+	bindings     []*binding
+	validates    []FormValidate
+	errors       map[string]error
+	summary      *errorSummary
+	width        int
+	height       int
+	minWidth     int
+	maxWidth     int
+	columns      int
+	gutterX      int
+	gutterY      int
+	cells        map[Component]Cell
+	groups       [][]Component
+	readingOrder ReadingOrder
+	tabOrder     []int
+	sections     map[Component]*Fieldset
+	rules        []*formRule
+	hidden       map[Component]bool
+	onChange     func(key string, old, new interface{})
+	onSubmit     func(fd *FormData) error
+	onCancel     func() error
+	modals       *ModalManager
+	initial      *FormData
+	drawn        map[Component]bool
+	offset       int
+	contentH     int
 	*Position
 }
 
-// FormData form data struct
-type FormData struct {
-	inputs    map[string]string
-	checkBoxs map[string]bool
-	selects   map[string]string
-	radio     map[string]string
-}
-
 // NewForm new form
 func NewForm(gui *gocui.Gui, name string, x, y, w, h int) *Form {
 	f := &Form{
 		Gui:        gui,
 		activeItem: 0,
 		name:       name,
+		title:      name,
+		width:      w,
+		height:     h,
+		gutterX:    2,
 		Position: &Position{
 			X: x,
 			Y: y,
@@ -47,23 +76,14 @@ func NewForm(gui *gocui.Gui, name string, x, y, w, h int) *Form {
 
 // AddInputField add input field to form
 func (f *Form) AddInputField(label string, labelWidth, fieldWidth int) *InputField {
-	var y int
-
-	p := f.getLastViewPosition()
-	if p != nil {
-		y = p.H
-	} else {
-		y = f.Y + 1
-	}
-
 	input := NewInputField(
 		f.Gui,
 		label,
 		f.X+1,
-		y,
+		f.Y,
 		labelWidth,
 		fieldWidth,
-	)
+	).SetID(f.newID(label))
 
 	f.inputs = append(f.inputs, input)
 	f.components = append(f.components, input)
@@ -73,30 +93,13 @@ func (f *Form) AddInputField(label string, labelWidth, fieldWidth int) *InputFie
 
 // AddButton add button to form
 func (f *Form) AddButton(label string, handler Handler) *Button {
-	var x int
-	var y int
-
-	p := f.getLastViewPosition()
-	if p != nil {
-		if f.isButtonLastView() {
-			x = p.W
-			y = p.Y - 1
-		} else {
-			x = f.X
-			y = p.H
-		}
-	} else {
-		x = f.X
-		y = f.Y
-	}
-
 	button := NewButton(
 		f.Gui,
 		label,
-		x+1,
-		y+1,
+		f.X+1,
+		f.Y+1,
 		len(label),
-	)
+	).SetID(f.newID(label))
 
 	button.AddHandler(gocui.KeyEnter, handler)
 
@@ -108,22 +111,13 @@ func (f *Form) AddButton(label string, handler Handler) *Button {
 
 // AddCheckBox add checkbox
 func (f *Form) AddCheckBox(label string, width int) *CheckBox {
-	var y int
-
-	p := f.getLastViewPosition()
-	if p != nil {
-		y = p.H
-	} else {
-		y = f.Y
-	}
-
 	checkbox := NewCheckBox(
 		f.Gui,
 		label,
 		f.X+1,
-		y,
+		f.Y,
 		width,
-	)
+	).SetID(f.newID(label))
 
 	f.checkBoxs = append(f.checkBoxs, checkbox)
 	f.components = append(f.components, checkbox)
@@ -133,23 +127,14 @@ func (f *Form) AddCheckBox(label string, width int) *CheckBox {
 
 // AddSelect add select
 func (f *Form) AddSelect(label string, labelWidth, listWidth int) *Select {
-	var y int
-
-	p := f.getLastViewPosition()
-	if p != nil {
-		y = p.H
-	} else {
-		y = f.Y
-	}
-
 	Select := NewSelect(
 		f.Gui,
 		label,
 		f.X+1,
-		y,
+		f.Y,
 		labelWidth,
 		listWidth,
-	)
+	).SetID(f.newID(label))
 
 	f.selects = append(f.selects, Select)
 	f.components = append(f.components, Select)
@@ -159,16 +144,8 @@ func (f *Form) AddSelect(label string, labelWidth, listWidth int) *Select {
 
 // AddRadio add radio
 func (f *Form) AddRadio(label string, width int) *Radio {
-	var x, y int
-
-	p := f.getLastViewPosition()
-	if p != nil {
-		y = p.H
-	} else {
-		y = f.Y
-	}
-
-	radio := NewRadio(f.Gui, label, x+1, y, width)
+	radio := NewRadio(f.Gui, label, f.X+1, f.Y, width).
+		SetID(f.newID(label))
 
 	f.radios = append(f.radios, radio)
 	f.components = append(f.components, radio)
@@ -176,6 +153,48 @@ func (f *Form) AddRadio(label string, width int) *Radio {
 	return radio
 }
 
+// AddTable add table
+func (f *Form) AddTable(label string, width, height int) *Table {
+	table := NewTable(f.Gui, label, f.X+1, f.Y, width, height).
+		SetID(f.newID(label))
+
+	f.tables = append(f.tables, table)
+	f.components = append(f.components, table)
+
+	return table
+}
+
+// AddTextArea add text area
+func (f *Form) AddTextArea(label string, labelWidth, fieldWidth, height int) *TextArea {
+	textArea := NewTextArea(
+		f.Gui,
+		label,
+		f.X+1,
+		f.Y,
+		labelWidth,
+		fieldWidth,
+		height,
+	).SetID(f.newID(label))
+
+	f.textAreas = append(f.textAreas, textArea)
+	f.components = append(f.components, textArea)
+
+	return textArea
+}
+
+// SetTitle set title of form frame, default title is form name
+func (f *Form) SetTitle(title string) *Form {
+	f.title = title
+	return f
+}
+
+// OnChange set handler which is called when value of any component is changed
+// key is the same as key of form data such as "Name" or "Network.Host"
+func (f *Form) OnChange(handler func(key string, old, new interface{})) *Form {
+	f.onChange = handler
+	return f
+}
+
 // AddCloseFunc add close function
 func (f *Form) AddCloseFunc(function func() error) {
 	f.closeFuncs = append(f.closeFuncs, function)
@@ -190,7 +209,7 @@ func (f *Form) GetFieldTexts() map[string]string {
 	}
 
 	for _, item := range f.inputs {
-		data[item.GetLabel()] = item.GetFieldText()
+		data[f.keyOf(item)] = item.GetFieldText()
 	}
 
 	return data
@@ -210,7 +229,7 @@ func (f *Form) GetCheckBoxStates() map[string]bool {
 	}
 
 	for _, box := range f.checkBoxs {
-		state[box.GetLabel()] = box.IsChecked()
+		state[f.keyOf(box)] = box.IsChecked()
 	}
 
 	return state
@@ -230,7 +249,7 @@ func (f *Form) GetSelectedOpts() map[string]string {
 	}
 
 	for _, Select := range f.selects {
-		opts[Select.GetLabel()] = Select.GetSelected()
+		opts[f.keyOf(Select)] = Select.GetSelected()
 	}
 
 	return opts
@@ -245,7 +264,7 @@ func (f *Form) GetSelectedOpt(target string) string {
 func (f *Form) GetSelectedRadios() map[string]string {
 	radios := map[string]string{}
 	for _, r := range f.radios {
-		radios[r.GetLabel()] = r.GetSelected()
+		radios[f.keyOf(r)] = r.GetSelected()
 	}
 
 	return radios
@@ -256,6 +275,36 @@ func (f *Form) GetSelectedRadio(target string) string {
 	return f.GetSelectedOpts()[target]
 }
 
+// GetSelectedRows get selected table rows
+func (f *Form) GetSelectedRows() map[string][]string {
+	rows := map[string][]string{}
+	for _, t := range f.tables {
+		rows[f.keyOf(t)] = t.GetSelectedRow()
+	}
+
+	return rows
+}
+
+// GetSelectedRow get selected table row
+func (f *Form) GetSelectedRow(target string) []string {
+	return f.GetSelectedRows()[target]
+}
+
+// GetTextAreaTexts get text area texts
+func (f *Form) GetTextAreaTexts() map[string]string {
+	texts := map[string]string{}
+	for _, t := range f.textAreas {
+		texts[f.keyOf(t)] = t.GetFieldText()
+	}
+
+	return texts
+}
+
+// GetTextAreaText get text area text
+func (f *Form) GetTextAreaText(target string) string {
+	return f.GetTextAreaTexts()[target]
+}
+
 // GetRadioText get radio text
 func (f *Form) GetRadioText() string {
 	if len(f.radios) == 0 {
@@ -265,18 +314,77 @@ func (f *Form) GetRadioText() string {
 	return f.radios[f.activeRadio].GetLabel()
 }
 
-// GetFormData get form data
+// GetFormData get form data in form order
+// components which have the same label are numbered such as "Name", "Name#2"
+// components which are hidden by rule are not included
 func (f *Form) GetFormData() *FormData {
-	fd := &FormData{
-		inputs:    f.GetFieldTexts(),
-		checkBoxs: f.GetCheckBoxStates(),
-		selects:   f.GetSelectedOpts(),
-		radio:     f.GetSelectedRadios(),
+	return f.formData(false)
+}
+
+// formData get form data, values of hidden components are included if hidden is true
+func (f *Form) formData(hidden bool) *FormData {
+	fd := NewFormData()
+
+	for _, c := range f.components {
+		if !hidden && !f.IsVisible(c) {
+			continue
+		}
+
+		if v, ok := c.(Valuer); ok {
+			fd.Add(f.keyOf(c), c.GetType(), v.GetValue())
+		}
 	}
 
 	return fd
 }
 
+// SetFormData set values to components which have the same keys
+// fields which do not match any component are ignored
+// errors of values which cannot be set are returned as FieldErrors
+func (f *Form) SetFormData(fd *FormData) error {
+	errs := FieldErrors{}
+
+	for _, field := range fd.Fields() {
+		v, ok := f.componentByLabel(field.Label).(Valuer)
+		if !ok {
+			continue
+		}
+
+		if err := v.SetValue(field.Value); err != nil {
+			errs[field.Label] = err
+		}
+	}
+
+	if len(errs) != 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// Reset restore values when form was drawn first or submitted, and clear validation errors
+// values are not changed before form is drawn, they are the initial values
+func (f *Form) Reset() error {
+	f.errors = nil
+	if err := f.closeErrorSummary(); err != nil {
+		return err
+	}
+
+	for _, c := range f.components {
+		if h, ok := c.(validatorHolder); ok {
+			if err := h.getValidator().clear(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if f.initial == nil {
+		return nil
+	}
+
+	return f.SetFormData(f.initial)
+}
+
 // GetInputs get inputs
 func (f *Form) GetInputs() []*InputField {
 	return f.inputs
@@ -302,6 +410,26 @@ func (f *Form) GetRadios() []*Radio {
 	return f.radios
 }
 
+// GetTables get tables
+func (f *Form) GetTables() []*Table {
+	return f.tables
+}
+
+// GetTextAreas get text areas
+func (f *Form) GetTextAreas() []*TextArea {
+	return f.textAreas
+}
+
+// ComponentByID get component by id, return nil if not found
+func (f *Form) ComponentByID(id string) Component {
+	for _, c := range f.components {
+		if c.GetID() == id {
+			return c
+		}
+	}
+	return nil
+}
+
 // GetItems get items
 func (f *Form) GetItems() []Component {
 	return f.components
@@ -310,6 +438,8 @@ func (f *Form) GetItems() []Component {
 // SetCurrentItem set current item index
 func (f *Form) SetCurrentItem(index int) *Form {
 	f.activeItem = index
+	// the component is displayed on next Layout even if scrolling failed
+	_ = f.scrollToItem(index)
 	f.components[index].Focus()
 	return f
 }
@@ -319,82 +449,170 @@ func (f *Form) GetCurrentItem() int {
 	return f.activeItem
 }
 
-// Validate validate form items
-func (f *Form) Validate() bool {
-	isValid := true
-	for _, item := range f.inputs {
-		if !item.Validate() {
-			isValid = false
-		}
-	}
-
-	return isValid
-}
-
-// NextItem to next item
+// NextItem to next item in reading order
 func (f *Form) NextItem(g *gocui.Gui, v *gocui.View) error {
 	f.components[f.activeItem].UnFocus()
-	f.activeItem = (f.activeItem + 1) % len(f.components)
+	f.activeItem = f.tabStep(1)
+	if err := f.scrollToItem(f.activeItem); err != nil {
+		return err
+	}
 	f.components[f.activeItem].Focus()
 	return nil
 }
 
-// PreItem to pre item
+// PreItem to pre item in reading order
 func (f *Form) PreItem(g *gocui.Gui, v *gocui.View) error {
 	f.components[f.activeItem].UnFocus()
-
-	if f.activeItem-1 < 0 {
-		f.activeItem = len(f.components) - 1
-	} else {
-		f.activeItem = (f.activeItem - 1) % len(f.components)
+	f.activeItem = f.tabStep(-1)
+	if err := f.scrollToItem(f.activeItem); err != nil {
+		return err
 	}
-
 	f.components[f.activeItem].Focus()
 
 	return nil
 }
 
 // Draw form
-func (f *Form) Draw() {
-	if v, err := f.Gui.SetView(f.name, f.X, f.Y, f.W+1, f.H+1); err != nil {
-		if err != gocui.ErrUnknownView {
-			panic(err)
+func (f *Form) Draw() error {
+	for _, cp := range f.components {
+		cp.AddHandlerOnly(gocui.KeyTab, f.NextItem)
+		cp.AddHandlerOnly(gocui.KeyArrowDown, f.NextItem)
+		cp.AddHandlerOnly(gocui.KeyArrowUp, f.PreItem)
+		cp.AddHandlerOnly(gocui.KeyPgdn, f.NextPage)
+		cp.AddHandlerOnly(gocui.KeyPgup, f.PrePage)
+		if f.summary != nil {
+			cp.AddHandlerOnly(gocui.KeyCtrlE, f.focusErrorSummary)
+		}
+		if f.onSubmit != nil {
+			cp.AddHandlerOnly(gocui.KeyCtrlS, f.Submit)
+		}
+		if f.onCancel != nil {
+			cp.AddHandlerOnly(gocui.KeyEsc, f.Cancel)
+		}
+		if n, ok := cp.(changeNotifier); ok {
+			c := cp
+			n.setNotify(func(old, new interface{}) error {
+				return f.valueChanged(c, old, new)
+			})
+		}
+		if n, ok := cp.(disableNotifier); ok {
+			n.setDisableNotify(f.disabledChanged)
 		}
+	}
+
+	// values when form is drawn first are compared by IsDirty
+	if f.initial == nil {
+		f.initial = f.formData(true)
+	}
 
-		v.Title = f.name
+	f.applyRules()
+	f.layout()
+	if err := f.draw(); err != nil {
+		return err
 	}
 
+	// errors of last validation are displayed again
+	if f.summary != nil && len(f.errors) != 0 {
+		if err := f.drawErrorSummary(); err != nil {
+			return err
+		}
+	}
+
+	// focus the first component in reading order
+	if len(f.components) != 0 {
+		f.activeItem = f.tabOrder[0]
+		if !f.canFocus(f.activeItem) {
+			f.activeItem = f.tabStep(1)
+		}
+		f.components[f.activeItem].Focus()
+	}
+
+	return nil
+}
+
+func (f *Form) draw() error {
+	v, err := setView(f.Gui, f.name, f.X, f.Y, f.W+1, f.H+1)
+	if err != nil && err != gocui.ErrUnknownView {
+		return newViewError(f.name, err)
+	}
+	v.Title = f.title
+
+	if f.drawn == nil {
+		f.drawn = make(map[Component]bool)
+	}
+
+	prev := f.Gui.CurrentView()
+	cursor := f.Gui.Cursor
+	created := false
+
 	for _, cp := range f.components {
-		p := cp.GetPosition()
-		if p.W > f.W {
-			f.W = p.W
+		// components in collapsed fieldset or out of view are closed
+		if f.isHidden(cp) || !f.inView(cp) {
+			if f.drawn[cp] {
+				delete(f.drawn, cp)
+				if err := cp.Close(); err != nil {
+					return err
+				}
+			}
+			continue
 		}
-		if p.H > f.H {
-			f.H = p.H
+
+		if err := cp.Draw(); err != nil {
+			return err
+		}
+		f.dim(cp)
+
+		vh, ok := cp.(validatorHolder)
+		if !f.drawn[cp] {
+			f.drawn[cp] = true
+			created = true
+
+			// error message is closed with component
+			if ok && !vh.getValidator().IsValid() {
+				if err := vh.getValidator().DispValidateMsg(); err != nil {
+					return err
+				}
+			}
+		} else if ok {
+			if err := vh.getValidator().moveMsg(); err != nil {
+				return err
+			}
 		}
-		cp.AddHandlerOnly(gocui.KeyTab, f.NextItem)
-		cp.AddHandlerOnly(gocui.KeyArrowDown, f.NextItem)
-		cp.AddHandlerOnly(gocui.KeyArrowUp, f.PreItem)
-		cp.Draw()
 	}
 
-	f.SetView(f.name, f.X, f.Y, f.W+1, f.H+1)
+	// component takes focus when it is drawn first, give it back
+	if created && prev != nil {
+		f.Gui.Cursor = cursor
+		if _, err := f.Gui.SetCurrentView(prev.Name()); err != nil && err != gocui.ErrUnknownView {
+			return newViewError(prev.Name(), err)
+		}
+	}
 
-	if len(f.components) != 0 {
-		f.components[0].Focus()
+	if err := f.drawScrollbar(); err != nil {
+		return err
 	}
+
+	return f.moveErrorSummary()
 }
 
 // Close close form
+// all components are closed even if some of them failed, and the first error is returned
 func (f *Form) Close(g *gocui.Gui, v *gocui.View) error {
-	if err := f.Gui.DeleteView(f.name); err != nil {
-		if err != gocui.ErrUnknownView {
-			panic(err)
-		}
+	firstErr := deleteView(f.Gui, f.name)
+	f.drawn = nil
+
+	if err := deleteView(f.Gui, viewName(f.name, "scrollbar")); err != nil && firstErr == nil {
+		firstErr = err
 	}
 
 	for _, c := range f.components {
-		c.Close()
+		if err := c.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if err := f.closeErrorSummary(); err != nil && firstErr == nil {
+		firstErr = err
 	}
 
 	if len(f.closeFuncs) != 0 {
@@ -403,23 +621,45 @@ func (f *Form) Close(g *gocui.Gui, v *gocui.View) error {
 		}
 	}
 
-	return nil
+	return firstErr
 }
 
-func (f *Form) getLastViewPosition() *Position {
-	cpl := len(f.components)
-	if cpl == 0 {
-		return nil
+// keyOf get unique key of component value
+// component in fieldset is qualified with the fieldset title such as "Network.Host",
+// and the same keys are numbered like ids such as "Name", "Name#2"
+func (f *Form) keyOf(c Component) string {
+	key := f.labelOf(c)
+
+	n := 1
+	for _, other := range f.components {
+		if other == c {
+			break
+		}
+		if f.labelOf(other) == key {
+			n++
+		}
 	}
 
-	return f.components[cpl-1].GetPosition()
+	if n > 1 {
+		return fmt.Sprintf("%s#%d", key, n)
+	}
+	return key
 }
 
-func (f *Form) isButtonLastView() bool {
-	cpl := len(f.components)
-	if cpl == 0 {
-		return false
+// labelOf get label of component which is qualified with the fieldset title
+func (f *Form) labelOf(c Component) string {
+	if fs := f.sections[c]; fs != nil {
+		return fs.title + "." + c.GetLabel()
 	}
+	return c.GetLabel()
+}
 
-	return f.components[cpl-1].GetType() == TypeButton
+// newID get unique id of component in form
+// the same labels are numbered such as "form/Name", "form/Name#2"
+func (f *Form) newID(label string) string {
+	id := scopedID(f.name, label)
+	for n := 2; f.ComponentByID(id) != nil; n++ {
+		id = fmt.Sprintf("%s#%d", scopedID(f.name, label), n)
+	}
+	return id
 }
@@ -0,0 +1,433 @@
+package component
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jroimartin/gocui"
+)
+
+const (
+	tagName           = "form"
+	defaultFieldWidth = 20
+	defaultAreaHeight = 3
+)
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// FieldErrors errors of each struct field
+type FieldErrors map[string]error
+
+// Error return all errors
+func (e FieldErrors) Error() string {
+	names := make([]string, 0, len(e))
+	for name := range e {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	msgs := make([]string, len(names))
+	for i, name := range names {
+		msgs[i] = fmt.Sprintf("%s: %s", name, e[name])
+	}
+
+	return strings.Join(msgs, ", ")
+}
+
+// binding struct field and component
+type binding struct {
+	index     int
+	name      string
+	options   []string
+	component Component
+}
+
+// fieldTag parsed form tag
+type fieldTag struct {
+	label      string
+	labelWidth int
+	width      int
+	height     int
+	mask       bool
+	radio      bool
+	textArea   bool
+	options    []string
+}
+
+// NewFormFromStruct new form which has components built from struct fields
+//
+// the struct fields are configured with form tag like
+//
+//	Host string `form:"label=Host,width=20"`
+//	Password string `form:"mask"`
+//	Mode string `form:"options=a|b|c,radio"`
+//	Memo string `form:"textarea,height=5"`
+//	Ignore string `form:"-"`
+func NewFormFromStruct(gui *gocui.Gui, name string, ptr interface{}) (*Form, error) {
+	rv, err := structValue(ptr)
+	if err != nil {
+		return nil, err
+	}
+
+	f := NewForm(gui, name, 0, 0, 0, 0)
+	f.bindType = rv.Type()
+
+	var tags []*fieldTag
+	var indexes []int
+	labelWidth := 0
+
+	for i := 0; i < rv.NumField(); i++ {
+		sf := rv.Type().Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		tag, err := parseTag(sf)
+		if err != nil {
+			return nil, err
+		}
+		if tag == nil {
+			continue
+		}
+
+		if len(tag.label) > labelWidth {
+			labelWidth = len(tag.label)
+		}
+
+		tags = append(tags, tag)
+		indexes = append(indexes, i)
+	}
+
+	for i, tag := range tags {
+		if tag.labelWidth == 0 {
+			tag.labelWidth = labelWidth + 1
+		}
+
+		sf := rv.Type().Field(indexes[i])
+		c, err := f.addStructField(tag, sf, rv.Field(indexes[i]))
+		if err != nil {
+			return nil, err
+		}
+
+		f.bindings = append(f.bindings, &binding{
+			index:     indexes[i],
+			name:      sf.Name,
+			options:   tag.options,
+			component: c,
+		})
+	}
+
+	return f, nil
+}
+
+// Bind write component values to struct fields
+// ptr must be the same type which is used in NewFormFromStruct
+func (f *Form) Bind(ptr interface{}) error {
+	rv, err := structValue(ptr)
+	if err != nil {
+		return err
+	}
+
+	if f.bindType == nil {
+		return errors.New("form is not created from struct")
+	}
+
+	if rv.Type() != f.bindType {
+		return fmt.Errorf("cannot bind %s to form of %s", rv.Type(), f.bindType)
+	}
+
+	errs := FieldErrors{}
+
+	for _, b := range f.bindings {
+		if err := b.bind(rv.Field(b.index)); err != nil {
+			errs[b.name] = err
+		}
+	}
+
+	if len(errs) != 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func (f *Form) addStructField(tag *fieldTag, sf reflect.StructField, fv reflect.Value) (Component, error) {
+	switch {
+	case len(tag.options) != 0:
+		index, err := optionIndex(fv, tag.options)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", sf.Name, err)
+		}
+
+		if tag.radio {
+			r := f.AddRadio(tag.label, tag.labelWidth).
+				SetMode(VerticalMode).
+				AddOptions(tag.options...)
+			r.active = index
+			r.checked = index
+			return r, nil
+		}
+
+		s := f.AddSelect(tag.label, tag.labelWidth, tag.width).
+			AddOptions(tag.options...)
+		s.currentOpt = index
+		return s, nil
+	case fv.Kind() == reflect.Bool:
+		c := f.AddCheckBox(tag.label, tag.labelWidth)
+		c.isChecked = fv.Bool()
+		return c, nil
+	}
+
+	text, err := formatValue(fv)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", sf.Name, err)
+	}
+
+	if tag.textArea {
+		return f.AddTextArea(tag.label, tag.labelWidth, tag.width, tag.height).
+			SetText(text), nil
+	}
+
+	i := f.AddInputField(tag.label, tag.labelWidth, tag.width).
+		SetText(text)
+	if tag.mask {
+		i.SetMask()
+	}
+
+	return i, nil
+}
+
+func (b *binding) bind(fv reflect.Value) error {
+	switch c := b.component.(type) {
+	case *CheckBox:
+		fv.SetBool(c.IsChecked())
+		return nil
+	case *Select:
+		return setOption(fv, b.options, c.currentOpt)
+	case *Radio:
+		return setOption(fv, b.options, c.checked)
+	case *InputField:
+		return setValue(fv, c.GetFieldText())
+	case *TextArea:
+		return setValue(fv, c.GetFieldText())
+	}
+
+	return fmt.Errorf("unsupported component %T", b.component)
+}
+
+func structValue(ptr interface{}) (reflect.Value, error) {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("%T is not a pointer to struct", ptr)
+	}
+
+	return rv.Elem(), nil
+}
+
+// parseTag parse form tag, return nil if the field is ignored
+func parseTag(sf reflect.StructField) (*fieldTag, error) {
+	value := sf.Tag.Get(tagName)
+	if value == "-" {
+		return nil, nil
+	}
+
+	tag := &fieldTag{
+		label:  sf.Name,
+		width:  defaultFieldWidth,
+		height: defaultAreaHeight,
+	}
+
+	if value == "" {
+		return tag, nil
+	}
+
+	for _, item := range strings.Split(value, ",") {
+		kv := strings.SplitN(strings.TrimSpace(item), "=", 2)
+		key := kv[0]
+
+		if len(kv) == 1 {
+			switch key {
+			case "mask":
+				tag.mask = true
+			case "radio":
+				tag.radio = true
+			case "textarea":
+				tag.textArea = true
+			default:
+				return nil, fmt.Errorf("%s: unknown tag option %q", sf.Name, key)
+			}
+			continue
+		}
+
+		var err error
+		switch key {
+		case "label":
+			tag.label = kv[1]
+		case "labelWidth":
+			tag.labelWidth, err = strconv.Atoi(kv[1])
+		case "width":
+			tag.width, err = strconv.Atoi(kv[1])
+		case "height":
+			tag.height, err = strconv.Atoi(kv[1])
+		case "options":
+			tag.options = strings.Split(kv[1], "|")
+		default:
+			return nil, fmt.Errorf("%s: unknown tag option %q", sf.Name, key)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid %s: %s", sf.Name, key, err)
+		}
+	}
+
+	return tag, nil
+}
+
+// formatValue convert field value to text
+func formatValue(fv reflect.Value) (string, error) {
+	if fv.Type().Implements(textMarshalerType) {
+		b, err := fv.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		return fv.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if fv.Type() == durationType {
+			return time.Duration(fv.Int()).String(), nil
+		}
+		return strconv.FormatInt(fv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(fv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(fv.Float(), 'g', -1, fv.Type().Bits()), nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", fv.Type())
+}
+
+// setValue convert text and set it to field
+func setValue(fv reflect.Value, text string) error {
+	if fv.Addr().Type().Implements(textUnmarshalerType) {
+		return fv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text))
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(text)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if fv.Type() == durationType {
+			d, err := time.ParseDuration(text)
+			if err != nil {
+				return err
+			}
+			fv.SetInt(int64(d))
+			return nil
+		}
+
+		n, err := strconv.ParseInt(text, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(text, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(text, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported type %s", fv.Type())
+	}
+
+	return nil
+}
+
+// optionIndex get index of option which is the field value
+// integer field is treated as option index, and zero value selects the first option
+func optionIndex(fv reflect.Value, options []string) (int, error) {
+	if isOptionIndex(fv.Type()) {
+		switch fv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if i := fv.Int(); i >= 0 && i < int64(len(options)) {
+				return int(i), nil
+			}
+			return 0, fmt.Errorf("option index %d is out of range", fv.Int())
+		default:
+			if i := fv.Uint(); i < uint64(len(options)) {
+				return int(i), nil
+			}
+			return 0, fmt.Errorf("option index %d is out of range", fv.Uint())
+		}
+	}
+
+	if fv.IsZero() {
+		return 0, nil
+	}
+
+	text, err := formatValue(fv)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, opt := range options {
+		if opt == text {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%q is not in options", text)
+}
+
+// setOption set selected option to field
+// integer field is set option index
+func setOption(fv reflect.Value, options []string, index int) error {
+	if index < 0 || index >= len(options) {
+		return fmt.Errorf("invalid option index %d", index)
+	}
+
+	if isOptionIndex(fv.Type()) {
+		switch fv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			fv.SetInt(int64(index))
+		default:
+			fv.SetUint(uint64(index))
+		}
+		return nil
+	}
+
+	return setValue(fv, options[index])
+}
+
+// isOptionIndex return true if field of the type has option index
+// duration and types which are converted to text have option text
+func isOptionIndex(t reflect.Type) bool {
+	if t == durationType || t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		return false
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+
+	return false
+}
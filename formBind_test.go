@@ -0,0 +1,318 @@
+package component
+
+import (
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jroimartin/gocui"
+)
+
+type bindConfig struct {
+	Host    string        `form:"label=Host,width=30"`
+	Port    int           `form:"label=Port"`
+	Ratio   float64       `form:"label=Ratio"`
+	Timeout time.Duration `form:"label=Timeout"`
+	Addr    net.IP        `form:"label=Addr"`
+	Debug   bool          `form:"label=Debug"`
+	Mode    string        `form:"label=Mode,options=dev|prod"`
+	Level   int           `form:"label=Level,options=low|high,radio"`
+	Ignore  string        `form:"-"`
+}
+
+// setComponent set text, check state or option index to component as user does
+func setComponent(c Component, value interface{}) {
+	switch c := c.(type) {
+	case *InputField:
+		c.SetText(value.(string))
+	case *CheckBox:
+		c.isChecked = value.(bool)
+	case *Select:
+		c.currentOpt = value.(int)
+	case *Radio:
+		c.checked = value.(int)
+	}
+}
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want *fieldTag
+		err  string
+	}{
+		{tag: "", want: &fieldTag{label: "Field", width: defaultFieldWidth, height: defaultAreaHeight}},
+		{tag: "-", want: nil},
+		{
+			tag:  "label=Name,labelWidth=8,width=30,mask",
+			want: &fieldTag{label: "Name", labelWidth: 8, width: 30, height: defaultAreaHeight, mask: true},
+		},
+		{
+			tag:  "options=a|b|c, radio",
+			want: &fieldTag{label: "Field", width: defaultFieldWidth, height: defaultAreaHeight, options: []string{"a", "b", "c"}, radio: true},
+		},
+		{
+			tag:  "textarea,height=5",
+			want: &fieldTag{label: "Field", width: defaultFieldWidth, height: 5, textArea: true},
+		},
+		{tag: "width=wide", err: "Field: invalid width"},
+		{tag: "bold", err: `Field: unknown tag option "bold"`},
+		{tag: "color=red", err: `Field: unknown tag option "color"`},
+	}
+
+	for _, tt := range tests {
+		sf := reflect.StructField{Name: "Field", Tag: reflect.StructTag(`form:"` + tt.tag + `"`)}
+		got, err := parseTag(sf)
+
+		if tt.err != "" {
+			if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+				t.Errorf("parseTag(%q) error = %v, want %q", tt.tag, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseTag(%q) error = %v", tt.tag, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseTag(%q) = %+v, want %+v", tt.tag, got, tt.want)
+		}
+	}
+}
+
+func TestSetValue(t *testing.T) {
+	var (
+		i   int
+		i8  int8
+		u   uint16
+		f   float32
+		d   time.Duration
+		s   string
+		ip  net.IP
+		bad bool
+	)
+
+	tests := []struct {
+		ptr  interface{}
+		text string
+		want interface{}
+		err  bool
+	}{
+		{ptr: &i, text: "-42", want: -42},
+		{ptr: &i, text: "4x", err: true},
+		{ptr: &i8, text: "127", want: int8(127)},
+		{ptr: &i8, text: "128", err: true},
+		{ptr: &u, text: "8080", want: uint16(8080)},
+		{ptr: &u, text: "-1", err: true},
+		{ptr: &f, text: "1.5", want: float32(1.5)},
+		{ptr: &f, text: "one", err: true},
+		{ptr: &d, text: "1m30s", want: 90 * time.Second},
+		{ptr: &d, text: "90", err: true},
+		{ptr: &s, text: "text", want: "text"},
+		{ptr: &ip, text: "10.0.0.1", want: net.ParseIP("10.0.0.1")},
+		{ptr: &ip, text: "10.0.0", err: true},
+		{ptr: &bad, text: "true", err: true},
+	}
+
+	for _, tt := range tests {
+		fv := reflect.ValueOf(tt.ptr).Elem()
+		err := setValue(fv, tt.text)
+
+		if tt.err {
+			if err == nil {
+				t.Errorf("setValue(%s, %q) error is nil", fv.Type(), tt.text)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("setValue(%s, %q) error = %v", fv.Type(), tt.text, err)
+			continue
+		}
+		if got := fv.Interface(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("setValue(%s, %q) = %v, want %v", fv.Type(), tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{value: -42, want: "-42"},
+		{value: uint8(7), want: "7"},
+		{value: 0.25, want: "0.25"},
+		{value: float32(1.1), want: "1.1"},
+		{value: 90 * time.Second, want: "1m30s"},
+		{value: "text", want: "text"},
+		{value: net.ParseIP("::1"), want: "::1"},
+	}
+
+	for _, tt := range tests {
+		got, err := formatValue(reflect.ValueOf(tt.value))
+		if err != nil || got != tt.want {
+			t.Errorf("formatValue(%v) = %q, %v, want %q", tt.value, got, err, tt.want)
+		}
+	}
+
+	if _, err := formatValue(reflect.ValueOf([]int{1})); err == nil {
+		t.Error("formatValue of slice error is nil")
+	}
+}
+
+func TestOptionIndex(t *testing.T) {
+	options := []string{"a", "b", "1s"}
+
+	tests := []struct {
+		value interface{}
+		want  int
+		err   bool
+	}{
+		{value: "b", want: 1},
+		{value: "", want: 0},
+		{value: "c", err: true},
+		{value: 2, want: 2},
+		{value: 3, err: true},
+		{value: -1, err: true},
+		{value: uint(1), want: 1},
+		{value: uint(5), err: true},
+		{value: time.Second, want: 2},
+		{value: time.Duration(0), want: 0},
+		{value: time.Minute, err: true},
+	}
+
+	for _, tt := range tests {
+		got, err := optionIndex(reflect.ValueOf(tt.value), options)
+
+		if tt.err {
+			if err == nil {
+				t.Errorf("optionIndex(%v) = %d, want error", tt.value, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("optionIndex(%v) = %d, %v, want %d", tt.value, got, err, tt.want)
+		}
+	}
+}
+
+func TestSetOption(t *testing.T) {
+	options := []string{"a", "1s"}
+
+	var (
+		s string
+		i int
+		d time.Duration
+	)
+
+	if err := setOption(reflect.ValueOf(&s).Elem(), options, 0); err != nil || s != "a" {
+		t.Errorf("setOption(string) = %q, %v", s, err)
+	}
+	if err := setOption(reflect.ValueOf(&i).Elem(), options, 1); err != nil || i != 1 {
+		t.Errorf("setOption(int) = %d, %v", i, err)
+	}
+	if err := setOption(reflect.ValueOf(&d).Elem(), options, 1); err != nil || d != time.Second {
+		t.Errorf("setOption(Duration) = %s, %v", d, err)
+	}
+	if err := setOption(reflect.ValueOf(&s).Elem(), options, 2); err == nil {
+		t.Error("setOption with invalid index error is nil")
+	}
+}
+
+func TestNewFormFromStructOptionError(t *testing.T) {
+	cfg := bindConfig{Mode: "test"}
+	if _, err := NewFormFromStruct(&gocui.Gui{}, "config", &cfg); err == nil || !strings.HasPrefix(err.Error(), "Mode:") {
+		t.Errorf("NewFormFromStruct error = %v, want error of Mode", err)
+	}
+
+	cfg = bindConfig{Level: 2}
+	if _, err := NewFormFromStruct(&gocui.Gui{}, "config", &cfg); err == nil || !strings.HasPrefix(err.Error(), "Level:") {
+		t.Errorf("NewFormFromStruct error = %v, want error of Level", err)
+	}
+}
+
+func TestBind(t *testing.T) {
+	cfg := bindConfig{
+		Host:    "localhost",
+		Port:    80,
+		Timeout: time.Second,
+		Mode:    "prod",
+		Level:   1,
+	}
+
+	f, err := NewFormFromStruct(&gocui.Gui{}, "config", &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(f.components); got != 8 {
+		t.Fatalf("form has %d fields, want 8", got)
+	}
+
+	values := map[string]interface{}{
+		"Host":    "example.com",
+		"Port":    "8080",
+		"Ratio":   "0.5",
+		"Timeout": "2m",
+		"Addr":    "192.168.0.1",
+		"Debug":   true,
+		"Mode":    0,
+		"Level":   0,
+	}
+	for _, b := range f.bindings {
+		setComponent(b.component, values[b.name])
+	}
+
+	var got bindConfig
+	if err := f.Bind(&got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := bindConfig{
+		Host:    "example.com",
+		Port:    8080,
+		Ratio:   0.5,
+		Timeout: 2 * time.Minute,
+		Addr:    net.ParseIP("192.168.0.1"),
+		Debug:   true,
+		Mode:    "dev",
+		Level:   0,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Bind() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBindErrors(t *testing.T) {
+	var cfg bindConfig
+
+	f, err := NewFormFromStruct(&gocui.Gui{}, "config", &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, b := range f.bindings {
+		switch b.name {
+		case "Port", "Timeout":
+			setComponent(b.component, "x")
+		}
+	}
+
+	err = f.Bind(&cfg)
+	errs, ok := err.(FieldErrors)
+	if !ok {
+		t.Fatalf("Bind() error = %v, want FieldErrors", err)
+	}
+	if len(errs) != 2 || errs["Port"] == nil || errs["Timeout"] == nil {
+		t.Errorf("Bind() errors = %v, want errors of Port and Timeout", errs)
+	}
+
+	var other struct{ Host string }
+	if err := f.Bind(&other); err == nil {
+		t.Error("Bind() of other type error is nil")
+	}
+	if err := f.Bind(cfg); err == nil {
+		t.Error("Bind() of non pointer error is nil")
+	}
+}
@@ -0,0 +1,220 @@
+package component
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// FormData form data struct
+type FormData struct {
+	fields []*FormField
+}
+
+// FormField value of form component
+// Label is unique key of the component such as "Name", "Name#2" or "Network.Host"
+type FormField struct {
+	Label string
+	Type  ComponentType
+	Value interface{}
+}
+
+// FieldDiff changed field value
+// Old or New is nil if the field does not exist
+type FieldDiff struct {
+	Label string
+	Old   interface{}
+	New   interface{}
+}
+
+// NewFormData new form data
+func NewFormData() *FormData {
+	return &FormData{}
+}
+
+// Add add field value
+// value of the same label is replaced, so labels are unique
+func (fd *FormData) Add(label string, ctype ComponentType, value interface{}) *FormData {
+	if f := fd.field(label); f != nil {
+		f.Type = ctype
+		f.Value = value
+		return fd
+	}
+
+	fd.fields = append(fd.fields, &FormField{
+		Label: label,
+		Type:  ctype,
+		Value: value,
+	})
+	return fd
+}
+
+// Fields get fields in form order
+func (fd *FormData) Fields() []FormField {
+	fields := make([]FormField, len(fd.fields))
+	for i, f := range fd.fields {
+		fields[i] = *f
+	}
+	return fields
+}
+
+// Labels get labels in form order
+func (fd *FormData) Labels() []string {
+	labels := make([]string, len(fd.fields))
+	for i, f := range fd.fields {
+		labels[i] = f.Label
+	}
+	return labels
+}
+
+// Len get number of fields
+func (fd *FormData) Len() int {
+	return len(fd.fields)
+}
+
+// Get get field value
+func (fd *FormData) Get(label string) (interface{}, bool) {
+	f := fd.field(label)
+	if f == nil {
+		return nil, false
+	}
+	return f.Value, true
+}
+
+// GetFieldText get input field text
+func (fd *FormData) GetFieldText(label string) string {
+	return fd.getString(label, TypeInputField)
+}
+
+// GetTextAreaText get text area text
+func (fd *FormData) GetTextAreaText(label string) string {
+	return fd.getString(label, TypeTextArea)
+}
+
+// GetSelectedOpt get selected option of select
+func (fd *FormData) GetSelectedOpt(label string) string {
+	return fd.getString(label, TypeSelect)
+}
+
+// GetSelectedRadio get selected option of radio
+func (fd *FormData) GetSelectedRadio(label string) string {
+	return fd.getString(label, TypeRadio)
+}
+
+// GetCheckBoxState get checkbox state
+func (fd *FormData) GetCheckBoxState(label string) bool {
+	if f := fd.fieldWithType(label, TypeCheckBox); f != nil {
+		b, _ := f.Value.(bool)
+		return b
+	}
+	return false
+}
+
+// GetSelectedRow get selected row of table
+func (fd *FormData) GetSelectedRow(label string) []string {
+	if f := fd.fieldWithType(label, TypeTable); f != nil {
+		row, _ := f.Value.([]string)
+		return row
+	}
+	return nil
+}
+
+// Diff get changed fields from fd to other
+// nil other is treated as empty, so every field is reported as removed
+func (fd *FormData) Diff(other *FormData) []FieldDiff {
+	if other == nil {
+		other = NewFormData()
+	}
+
+	var diffs []FieldDiff
+
+	for _, f := range fd.fields {
+		o := other.field(f.Label)
+		if o == nil {
+			diffs = append(diffs, FieldDiff{Label: f.Label, Old: f.Value})
+			continue
+		}
+
+		if !reflect.DeepEqual(f.Value, o.Value) {
+			diffs = append(diffs, FieldDiff{Label: f.Label, Old: f.Value, New: o.Value})
+		}
+	}
+
+	for _, o := range other.fields {
+		if fd.field(o.Label) == nil {
+			diffs = append(diffs, FieldDiff{Label: o.Label, New: o.Value})
+		}
+	}
+
+	return diffs
+}
+
+// MarshalJSON marshal to json object in form order
+func (fd *FormData) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+	for i, f := range fd.fields {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(f.Label)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(f.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// MarshalYAML marshal to yaml mapping in form order
+func (fd *FormData) MarshalYAML() (interface{}, error) {
+	items := make(yaml.MapSlice, len(fd.fields))
+	for i, f := range fd.fields {
+		items[i] = yaml.MapItem{
+			Key:   f.Label,
+			Value: f.Value,
+		}
+	}
+
+	return items, nil
+}
+
+func (fd *FormData) field(label string) *FormField {
+	for _, f := range fd.fields {
+		if f.Label == label {
+			return f
+		}
+	}
+	return nil
+}
+
+func (fd *FormData) fieldWithType(label string, ctype ComponentType) *FormField {
+	for _, f := range fd.fields {
+		if f.Label == label && f.Type == ctype {
+			return f
+		}
+	}
+	return nil
+}
+
+func (fd *FormData) getString(label string, ctype ComponentType) string {
+	if f := fd.fieldWithType(label, ctype); f != nil {
+		s, _ := f.Value.(string)
+		return s
+	}
+	return ""
+}
@@ -0,0 +1,144 @@
+package component
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/jroimartin/gocui"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func testFormData() *FormData {
+	return NewFormData().
+		Add("Name", TypeInputField, "gorilla").
+		Add("Admin", TypeCheckBox, true).
+		Add("Plan", TypeSelect, "free").
+		Add("Row", TypeTable, []string{"1", "a"})
+}
+
+func TestFormDataAdd(t *testing.T) {
+	fd := testFormData().Add("Admin", TypeCheckBox, false)
+
+	if got, want := fd.Labels(), []string{"Name", "Admin", "Plan", "Row"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Labels() = %v, want %v", got, want)
+	}
+	if fd.GetCheckBoxState("Admin") {
+		t.Error("Add of the same label did not replace value")
+	}
+}
+
+func TestFormDataGetters(t *testing.T) {
+	fd := testFormData()
+
+	if got := fd.GetFieldText("Name"); got != "gorilla" {
+		t.Errorf("GetFieldText() = %q", got)
+	}
+	if !fd.GetCheckBoxState("Admin") {
+		t.Error("GetCheckBoxState() = false")
+	}
+	if got := fd.GetSelectedOpt("Plan"); got != "free" {
+		t.Errorf("GetSelectedOpt() = %q", got)
+	}
+	if got := fd.GetSelectedRow("Row"); !reflect.DeepEqual(got, []string{"1", "a"}) {
+		t.Errorf("GetSelectedRow() = %v", got)
+	}
+
+	// type must match
+	if got := fd.GetTextAreaText("Name"); got != "" {
+		t.Errorf("GetTextAreaText() of input field = %q", got)
+	}
+	if _, ok := fd.Get("Missing"); ok {
+		t.Error("Get() of missing label is ok")
+	}
+}
+
+func TestFormDataDiff(t *testing.T) {
+	old := testFormData()
+	new := NewFormData().
+		Add("Name", TypeInputField, "gorilla").
+		Add("Admin", TypeCheckBox, false).
+		Add("Row", TypeTable, []string{"2", "b"}).
+		Add("Memo", TypeTextArea, "hello")
+
+	want := []FieldDiff{
+		{Label: "Admin", Old: true, New: false},
+		{Label: "Plan", Old: "free"},
+		{Label: "Row", Old: []string{"1", "a"}, New: []string{"2", "b"}},
+		{Label: "Memo", New: "hello"},
+	}
+
+	if got := old.Diff(new); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %+v, want %+v", got, want)
+	}
+	if got := old.Diff(testFormData()); len(got) != 0 {
+		t.Errorf("Diff() of the same data = %+v", got)
+	}
+	if got := old.Diff(nil); len(got) != old.Len() || got[0].New != nil {
+		t.Errorf("Diff(nil) = %+v, want all fields removed", got)
+	}
+}
+
+func TestFormDataMarshal(t *testing.T) {
+	fd := testFormData()
+
+	b, err := json.Marshal(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Name":"gorilla","Admin":true,"Plan":"free","Row":["1","a"]}`; string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+
+	b, err = yaml.Marshal(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Name: gorilla\nAdmin: true\nPlan: free\nRow:\n- \"1\"\n- a\n"; string(b) != want {
+		t.Errorf("yaml = %q, want %q", b, want)
+	}
+
+	b, err = json.Marshal(NewFormData())
+	if err != nil || string(b) != "{}" {
+		t.Errorf("json of empty data = %s, %v", b, err)
+	}
+}
+
+func TestFormDataDuplicateLabels(t *testing.T) {
+	f := NewForm(&gocui.Gui{}, "form", 0, 0, 0, 0)
+	first := f.AddInputField("A", 5, 10)
+	f.AddCheckBox("B", 5)
+	second := f.AddInputField("A", 5, 10)
+
+	fs := f.AddFieldset("Net")
+	fs.AddInputField("A", 5, 10)
+
+	first.SetText("x")
+	second.SetText("y")
+
+	fd := f.GetFormData()
+	if got, want := fd.Labels(), []string{"A", "B", "A#2", "Net.A"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Labels() = %v, want %v", got, want)
+	}
+
+	b, err := json.Marshal(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"A":"x","B":false,"A#2":"y","Net.A":""}`; string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+
+	other := f.GetFormData()
+	other.Add("A#2", TypeInputField, "z")
+	if got, want := fd.Diff(other), []FieldDiff{{Label: "A#2", Old: "y", New: "z"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %+v, want %+v", got, want)
+	}
+
+	if err := f.SetFormData(other); err != nil {
+		t.Fatal(err)
+	}
+	if first.GetFieldText() != "x" || second.GetFieldText() != "z" {
+		t.Errorf("SetFormData() set %q and %q", first.GetFieldText(), second.GetFieldText())
+	}
+}
@@ -0,0 +1,504 @@
+package component
+
+import (
+	"math"
+	"sort"
+
+	"github.com/jroimartin/gocui"
+)
+
+// ReadingOrder order of focus which is moved with Tab
+type ReadingOrder int
+
+const (
+	// RowFirstOrder focus moves from left to right, then to the next row
+	RowFirstOrder ReadingOrder = iota
+	// ColumnFirstOrder focus moves from top to bottom, then to the next column
+	ColumnFirstOrder
+)
+
+// Cell placement of component in grid
+// Row and Column start from 1, 0 means the cell next to the previous component
+type Cell struct {
+	Row    int
+	Column int
+	Span   int
+	Align  Align
+}
+
+// noLimit right edge which components never go over
+const noLimit = math.MaxInt32
+
+// layoutUnit components which are placed together
+type layoutUnit struct {
+	items []Component
+	gap   int
+}
+
+// SetMinWidth set minimum width of form
+// form keeps the width even if the terminal is narrower
+func (f *Form) SetMinWidth(width int) *Form {
+	f.minWidth = width
+	return f
+}
+
+// SetMaxWidth set maximum width of form
+// form is stretched to the terminal up to the width
+// if it is 0, form is as wide as the widest component
+func (f *Form) SetMaxWidth(width int) *Form {
+	f.maxWidth = width
+	return f
+}
+
+// SetGrid place components in grid which has the columns
+// if columns is 0, components are placed from top to bottom
+func (f *Form) SetGrid(columns int) *Form {
+	f.columns = columns
+	return f
+}
+
+// SetGutter set space between columns and rows
+func (f *Form) SetGutter(column, row int) *Form {
+	f.gutterX = column
+	f.gutterY = row
+	return f
+}
+
+// SetCell set cell of component in grid
+func (f *Form) SetCell(c Component, cell Cell) *Form {
+	if f.cells == nil {
+		f.cells = make(map[Component]Cell)
+	}
+	f.cells[c] = cell
+	return f
+}
+
+// Group place components side by side
+// group is placed in one cell of grid, and it is wrapped when it is too wide
+func (f *Form) Group(components ...Component) *Form {
+	if len(components) != 0 {
+		f.groups = append(f.groups, components)
+	}
+	return f
+}
+
+// SetReadingOrder set order of focus which is moved with Tab
+func (f *Form) SetReadingOrder(order ReadingOrder) *Form {
+	f.readingOrder = order
+	return f
+}
+
+// Layout recompute position of components and redraw form if it is displayed
+// it implements gocui.Manager, so the form follows the terminal size
+//
+//	gui.SetManager(form)
+func (f *Form) Layout(g *gocui.Gui) error {
+	if _, err := g.View(f.name); err != nil {
+		return nil
+	}
+
+	f.layout()
+	return f.draw()
+}
+
+// layout compute form width from the terminal size and place components in it
+func (f *Form) layout() {
+	maxX, maxY := f.Gui.Size()
+
+	right, _ := f.place(noLimit)
+
+	width := f.width
+	if right-f.X > width {
+		width = right - f.X
+	}
+	if f.maxWidth > 0 {
+		width = f.maxWidth
+	}
+
+	// frame of form is drawn at the right of W
+	if limit := maxX - 2 - f.X; width > limit {
+		width = limit
+	}
+	if width < f.minWidth {
+		width = f.minWidth
+	}
+
+	_, bottom := f.place(f.X + width)
+
+	f.W = f.X + width
+	f.H = f.Y + f.height
+	if bottom > f.H {
+		f.H = bottom
+	}
+
+	f.fitHeight(maxY)
+	f.sortTabOrder()
+}
+
+// place place components in form and return the right and bottom edge of them
+// fields are shrunk to fit in right
+func (f *Form) place(right int) (int, int) {
+	if f.columns > 0 {
+		return f.placeGrid(right)
+	}
+
+	return f.stack(f.units(), f.X+1, f.Y, right)
+}
+
+// stack place units from top to bottom
+// it returns the right and bottom edge of them
+func (f *Form) stack(units []*layoutUnit, x, y, right int) (int, int) {
+	maxW, maxH := x, y
+
+	for i, u := range units {
+		top := y
+		if i != 0 {
+			top += f.gutterY
+		}
+
+		// button has blank line above it, and so does input field at the top of form
+		first := u.items[0]
+		if first.GetType() == TypeButton {
+			top++
+		} else if _, ok := first.(*InputField); ok && i == 0 {
+			top++
+		}
+
+		w, h := f.flow(u, x, top, right)
+		y = h
+		if w > maxW {
+			maxW = w
+		}
+		if h > maxH {
+			maxH = h
+		}
+	}
+
+	return maxW, maxH
+}
+
+// placeGrid place components in cells of grid
+// columns have the same width, and rows are as high as the highest component in them
+func (f *Form) placeGrid(right int) (int, int) {
+	units := f.units()
+	columns := f.columns
+
+	columnWidth := (right - (f.X + 1) - f.gutterX*(columns-1)) / columns
+	if right == noLimit {
+		columnWidth = f.naturalColumnWidth(units)
+	}
+	if columnWidth < 1 {
+		columnWidth = 1
+	}
+
+	// assign cells in form order
+	type placed struct {
+		unit   *layoutUnit
+		column int
+		span   int
+		align  Align
+	}
+	rows := make(map[int][]placed)
+	row, column := 0, 0
+
+	for _, u := range units {
+		cell := f.cells[u.items[0]]
+		span := cell.Span
+		if span < 1 {
+			span = 1
+		}
+		if span > columns {
+			span = columns
+		}
+
+		if cell.Row > 0 {
+			if cell.Row-1 != row {
+				column = 0
+			}
+			row = cell.Row - 1
+		}
+		if cell.Column > 0 {
+			if cell.Column-1 < column && cell.Row == 0 {
+				row++
+			}
+			column = cell.Column - 1
+		}
+		if column+span > columns && column != 0 {
+			row++
+			column = 0
+		}
+
+		rows[row] = append(rows[row], placed{u, column, span, cell.Align})
+		column += span
+	}
+
+	indexes := make([]int, 0, len(rows))
+	for r := range rows {
+		indexes = append(indexes, r)
+	}
+	sort.Ints(indexes)
+
+	maxW := f.X + 1 + columns*columnWidth + (columns-1)*f.gutterX
+	maxH := f.Y
+	y := f.Y
+
+	for i, r := range indexes {
+		top := y + 1
+		if i != 0 {
+			top = y + f.gutterY
+			for _, p := range rows[r] {
+				if p.unit.items[0].GetType() == TypeButton {
+					top++
+					break
+				}
+			}
+		}
+
+		bottom := top
+		for _, p := range rows[r] {
+			x := f.X + 1 + p.column*(columnWidth+f.gutterX)
+			width := p.span*columnWidth + (p.span-1)*f.gutterX
+
+			w, h := f.flow(p.unit, x, top, x+width)
+
+			dx := 0
+			switch p.align {
+			case AlignCenter:
+				dx = (x + width - w) / 2
+			case AlignRight:
+				dx = x + width - w
+			}
+			if dx > 0 {
+				for _, c := range p.unit.items {
+					if pl, ok := c.(placeable); ok {
+						b := pl.getBounds()
+						pl.setPosition(b.X+dx, b.Y)
+					}
+				}
+				w += dx
+			}
+
+			if w > maxW {
+				maxW = w
+			}
+			if h > bottom {
+				bottom = h
+			}
+		}
+
+		y = bottom
+		if bottom > maxH {
+			maxH = bottom
+		}
+	}
+
+	return maxW, maxH
+}
+
+// naturalColumnWidth get column width which every component fits in
+func (f *Form) naturalColumnWidth(units []*layoutUnit) int {
+	width := 0
+	for _, u := range units {
+		w, _ := f.flow(u, 0, 0, noLimit)
+
+		span := f.cells[u.items[0]].Span
+		if span > f.columns {
+			span = f.columns
+		}
+		if span > 1 {
+			w = (w - f.gutterX*(span-1) + span - 1) / span
+		}
+
+		if w > width {
+			width = w
+		}
+	}
+
+	return width
+}
+
+// flow place components of unit from left to right and wrap them when they go over right
+// it returns the right and bottom edge of them
+func (f *Form) flow(u *layoutUnit, x, y, right int) (int, int) {
+	maxW, maxH := x, y
+	cx, top := x, y
+
+	for i, c := range u.items {
+		p, ok := c.(placeable)
+		if !ok {
+			continue
+		}
+
+		// decide wrapping with the width which is not shrunk
+		r, sizable := c.(resizable)
+		if sizable {
+			r.setWidth(noLimit)
+		}
+
+		b := p.getBounds()
+		if i != 0 && cx+b.W-b.X > right {
+			cx = x
+			top = maxH
+			if c.GetType() == TypeButton {
+				top++
+			}
+		}
+
+		p.setPosition(cx, top)
+		if sizable && right != noLimit {
+			r.setWidth(right - cx)
+		}
+
+		b = p.getBounds()
+		cx = b.W + u.gap
+		if b.W > maxW {
+			maxW = b.W
+		}
+		if b.H > maxH {
+			maxH = b.H
+		}
+	}
+
+	return maxW, maxH
+}
+
+// units get components which are placed together in form order
+// components in fieldset are placed by the fieldset
+func (f *Form) units() []*layoutUnit {
+	var components []Component
+	for _, c := range f.components {
+		if f.sections[c] == nil {
+			components = append(components, c)
+		}
+	}
+
+	return f.unitsOf(components, f.columns == 0)
+}
+
+// unitsOf get units of components
+// consecutive buttons are placed side by side if joinButtons is true
+// components which are hidden by rule do not take space
+func (f *Form) unitsOf(components []Component, joinButtons bool) []*layoutUnit {
+	var units []*layoutUnit
+	grouped := make(map[Component]bool)
+	buttons := false
+
+	for _, c := range components {
+		if grouped[c] || !f.IsVisible(c) {
+			continue
+		}
+
+		if g := f.groupOf(c); g != nil {
+			var items []Component
+			for _, m := range g {
+				grouped[m] = true
+				if f.IsVisible(m) {
+					items = append(items, m)
+				}
+			}
+			units = append(units, &layoutUnit{items: items, gap: f.gutterX})
+			buttons = false
+			continue
+		}
+
+		isButton := c.GetType() == TypeButton && joinButtons
+		if isButton && buttons {
+			last := units[len(units)-1]
+			last.items = append(last.items, c)
+			continue
+		}
+
+		units = append(units, &layoutUnit{items: []Component{c}, gap: 1})
+		buttons = isButton
+	}
+
+	return units
+}
+
+// groupOf get group which has component
+func (f *Form) groupOf(c Component) []Component {
+	for _, g := range f.groups {
+		for _, m := range g {
+			if m == c {
+				return g
+			}
+		}
+	}
+	return nil
+}
+
+// sortTabOrder sort components in reading order
+func (f *Form) sortTabOrder() {
+	f.tabOrder = f.tabOrder[:0]
+	for i := range f.components {
+		f.tabOrder = append(f.tabOrder, i)
+	}
+
+	bounds := make([]*Position, len(f.components))
+	for i, c := range f.components {
+		if p, ok := c.(placeable); ok {
+			bounds[i] = p.getBounds()
+		} else {
+			bounds[i] = c.GetPosition()
+		}
+	}
+
+	sort.SliceStable(f.tabOrder, func(i, j int) bool {
+		a, b := bounds[f.tabOrder[i]], bounds[f.tabOrder[j]]
+		if f.readingOrder == ColumnFirstOrder {
+			if a.X != b.X {
+				return a.X < b.X
+			}
+			return a.Y < b.Y
+		}
+		if a.Y != b.Y {
+			return a.Y < b.Y
+		}
+		return a.X < b.X
+	})
+}
+
+// tabStep get index of component which is n steps away from the active one in reading order
+// components which cannot be focused are skipped
+func (f *Form) tabStep(n int) int {
+	count := len(f.components)
+	order := f.tabOrder
+	if len(order) != count {
+		order = make([]int, count)
+		for i := range order {
+			order[i] = i
+		}
+	}
+
+	pos := 0
+	for i, index := range order {
+		if index == f.activeItem {
+			pos = i
+			break
+		}
+	}
+
+	for step := 1; step <= count; step++ {
+		index := order[((pos+n*step)%count+count)%count]
+		if f.canFocus(index) {
+			return index
+		}
+	}
+
+	return f.activeItem
+}
+
+// canFocus component can be focused if it is displayed and enabled
+func (f *Form) canFocus(index int) bool {
+	c := f.components[index]
+	return !f.isHidden(c) && f.IsEnabled(c)
+}
+
+// isHidden component is hidden by rule or in collapsed fieldset
+func (f *Form) isHidden(c Component) bool {
+	if !f.IsVisible(c) {
+		return true
+	}
+	fs := f.sections[c]
+	return fs != nil && fs.collapsed
+}
@@ -0,0 +1,174 @@
+package component
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/jroimartin/gocui"
+)
+
+func TestFormPlace(t *testing.T) {
+	tests := []struct {
+		name          string
+		right         int
+		width, bottom int
+		want          []Position
+	}{
+		{
+			name:  "no limit",
+			right: math.MaxInt32,
+			width: 27, bottom: 8,
+			want: []Position{{1, 1, 27, 3}, {1, 3, 27, 5}, {1, 6, 6, 8}, {7, 6, 16, 8}},
+		},
+		{
+			name:  "shrink fields",
+			right: 16,
+			width: 16, bottom: 8,
+			want: []Position{{1, 1, 16, 3}, {1, 3, 16, 5}, {1, 6, 6, 8}, {7, 6, 16, 8}},
+		},
+		{
+			name:  "wrap buttons",
+			right: 10,
+			width: 10, bottom: 11,
+			want: []Position{{1, 1, 10, 3}, {1, 3, 10, 5}, {1, 6, 6, 8}, {1, 9, 10, 11}},
+		},
+	}
+
+	for _, tt := range tests {
+		f := NewForm(&gocui.Gui{}, "form", 0, 0, 0, 0)
+		f.AddInputField("Name", 5, 20)
+		f.AddInputField("Mail", 5, 20)
+		f.AddButton("OK", nil)
+		f.AddButton("Cancel", nil)
+
+		width, bottom := f.place(tt.right)
+		if width != tt.width || bottom != tt.bottom {
+			t.Errorf("%s: place(%d) = %d, %d, want %d, %d", tt.name, tt.right, width, bottom, tt.width, tt.bottom)
+		}
+
+		for i, c := range f.components {
+			if got := *c.(placeable).getBounds(); got != tt.want[i] {
+				t.Errorf("%s: bounds of %s = %v, want %v", tt.name, c.GetLabel(), got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestFormFlow(t *testing.T) {
+	tests := []struct {
+		name          string
+		right         int
+		width, bottom int
+		want          []Position
+	}{
+		{
+			name:  "no limit",
+			right: math.MaxInt32,
+			width: 23, bottom: 2,
+			want: []Position{{1, 0, 7, 2}, {9, 0, 15, 2}, {17, 0, 23, 2}},
+		},
+		{
+			name:  "fit",
+			right: 23,
+			width: 23, bottom: 2,
+			want: []Position{{1, 0, 7, 2}, {9, 0, 15, 2}, {17, 0, 23, 2}},
+		},
+		{
+			name:  "wrap",
+			right: 16,
+			width: 15, bottom: 4,
+			want: []Position{{1, 0, 7, 2}, {9, 0, 15, 2}, {1, 2, 7, 4}},
+		},
+		{
+			name:  "one per line",
+			right: 12,
+			width: 7, bottom: 6,
+			want: []Position{{1, 0, 7, 2}, {1, 2, 7, 4}, {1, 4, 7, 6}},
+		},
+	}
+
+	for _, tt := range tests {
+		f := NewForm(&gocui.Gui{}, "form", 0, 0, 0, 0).SetGutter(2, 0)
+		f.Group(f.AddCheckBox("A", 3), f.AddCheckBox("B", 3), f.AddCheckBox("C", 3))
+
+		width, bottom := f.flow(f.units()[0], 1, 0, tt.right)
+		if width != tt.width || bottom != tt.bottom {
+			t.Errorf("%s: flow(%d) = %d, %d, want %d, %d", tt.name, tt.right, width, bottom, tt.width, tt.bottom)
+		}
+
+		for i, c := range f.components {
+			if got := *c.(placeable).getBounds(); got != tt.want[i] {
+				t.Errorf("%s: bounds of %s = %v, want %v", tt.name, c.GetLabel(), got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestFormGrid(t *testing.T) {
+	f := NewForm(&gocui.Gui{}, "form", 0, 0, 0, 0).SetGrid(2)
+	f.AddCheckBox("A", 3)
+	f.AddCheckBox("B", 3)
+	c := f.AddCheckBox("C", 3)
+	d := f.AddCheckBox("D", 3)
+	f.SetCell(c, Cell{Span: 2})
+	f.SetCell(d, Cell{Row: 4, Column: 2})
+
+	width, bottom := f.place(21)
+	if width != 21 || bottom != 7 {
+		t.Errorf("place(21) = %d, %d, want 21, 7", width, bottom)
+	}
+
+	// empty rows do not take space
+	want := []Position{{1, 1, 7, 3}, {12, 1, 18, 3}, {1, 3, 7, 5}, {12, 5, 18, 7}}
+	for i, c := range f.components {
+		if got := *c.(placeable).getBounds(); got != want[i] {
+			t.Errorf("bounds of %s = %v, want %v", c.GetLabel(), got, want[i])
+		}
+	}
+
+	tests := []struct {
+		order ReadingOrder
+		want  []int
+	}{
+		{RowFirstOrder, []int{0, 1, 2, 3}},
+		{ColumnFirstOrder, []int{0, 2, 1, 3}},
+	}
+
+	for _, tt := range tests {
+		f.SetReadingOrder(tt.order)
+		f.sortTabOrder()
+		if !reflect.DeepEqual(f.tabOrder, tt.want) {
+			t.Errorf("tab order of %v = %v, want %v", tt.order, f.tabOrder, tt.want)
+		}
+	}
+}
+
+func TestFormTabStep(t *testing.T) {
+	f := NewForm(&gocui.Gui{}, "form", 0, 0, 0, 0)
+	f.AddInputField("A", 3, 10)
+	b := f.AddInputField("B", 3, 10)
+	f.AddInputField("C", 3, 10)
+	d := f.AddInputField("D", 3, 10)
+
+	b.SetDisabled(true)
+	f.ShowIf(d, func(fd *FormData) bool { return false })
+	f.applyRules()
+
+	tests := []struct {
+		active, n, want int
+	}{
+		{active: 0, n: 1, want: 2},
+		{active: 2, n: 1, want: 0},
+		{active: 0, n: -1, want: 2},
+		{active: 2, n: -1, want: 0},
+		{active: 1, n: 1, want: 2},
+	}
+
+	for _, tt := range tests {
+		f.activeItem = tt.active
+		if got := f.tabStep(tt.n); got != tt.want {
+			t.Errorf("tabStep(%d) from %d = %d, want %d", tt.n, tt.active, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package component
+
+// formRule rule which shows or enables component by values of other components
+type formRule struct {
+	component Component
+	cond      func(fd *FormData) bool
+	enable    bool
+}
+
+// ShowIf display component only when cond returns true
+// hidden component is skipped by Tab, and it is not included in form data and validation
+// fd has values of displayed components, so rules can depend on each other
+func (f *Form) ShowIf(c Component, cond func(fd *FormData) bool) *Form {
+	f.rules = append(f.rules, &formRule{component: c, cond: cond})
+	return f
+}
+
+// EnableIf enable component only when cond returns true
+// it calls SetDisabled of component, so disabled component is dimmed, skipped by Tab and not validated
+func (f *Form) EnableIf(c Component, cond func(fd *FormData) bool) *Form {
+	f.rules = append(f.rules, &formRule{component: c, cond: cond, enable: true})
+	return f
+}
+
+// IsVisible return false if component is hidden by rule
+// component in fieldset is hidden with the fieldset
+func (f *Form) IsVisible(c Component) bool {
+	if f.hidden[c] {
+		return false
+	}
+	if fs := f.sections[c]; fs != nil {
+		return !f.hidden[fs]
+	}
+	return true
+}
+
+// IsEnabled return false if component or its fieldset is disabled
+func (f *Form) IsEnabled(c Component) bool {
+	if c.IsDisabled() {
+		return false
+	}
+	if fs := f.sections[c]; fs != nil {
+		return !fs.IsDisabled()
+	}
+	return true
+}
+
+// applyRules evaluate rules until they do not change any more
+// it returns true if visibility or enablement is changed
+func (f *Form) applyRules() bool {
+	if len(f.rules) == 0 {
+		return false
+	}
+
+	if f.hidden == nil {
+		f.hidden = make(map[Component]bool)
+	}
+
+	changed := false
+
+	// a rule can hide component which other rules depend on
+	for i := 0; i <= len(f.rules); i++ {
+		fd := f.GetFormData()
+		updated := false
+
+		for _, r := range f.rules {
+			off := !r.cond(fd)
+
+			if r.enable {
+				if r.component.IsDisabled() != off {
+					r.component.SetDisabled(off)
+					updated = true
+				}
+			} else if f.hidden[r.component] != off {
+				f.hidden[r.component] = off
+				updated = true
+			}
+		}
+
+		if !updated {
+			break
+		}
+		changed = true
+	}
+
+	return changed
+}
+
+// valueChanged call change handler of form, then evaluate rules and draw form again if they change components
+func (f *Form) valueChanged(c Component, old, new interface{}) error {
+	if f.onChange != nil {
+		f.onChange(f.keyOf(c), old, new)
+	}
+
+	if !f.applyRules() {
+		return nil
+	}
+
+	if _, err := f.Gui.View(f.name); err != nil {
+		return nil
+	}
+
+	f.layout()
+	if err := f.draw(); err != nil {
+		return err
+	}
+
+	// focus moves away from component which is hidden or disabled
+	if !f.canFocus(f.activeItem) {
+		f.components[f.activeItem].UnFocus()
+		f.activeItem = f.tabStep(1)
+		f.components[f.activeItem].Focus()
+	}
+
+	return nil
+}
+
+// disabledChanged move focus away from component which is disabled while it has focus
+func (f *Form) disabledChanged() {
+	if _, err := f.Gui.View(f.name); err != nil || len(f.components) == 0 {
+		return
+	}
+
+	if !f.canFocus(f.activeItem) {
+		f.components[f.activeItem].UnFocus()
+		f.activeItem = f.tabStep(1)
+		f.components[f.activeItem].Focus()
+	}
+}
+
+// dim dim component while its fieldset is disabled
+func (f *Form) dim(c Component) {
+	if d, ok := c.(dimmer); ok {
+		fs := f.sections[c]
+		d.setDimmed(fs != nil && fs.IsDisabled())
+	}
+}
@@ -0,0 +1,56 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/jroimartin/gocui"
+)
+
+func TestFormApplyRules(t *testing.T) {
+	f := NewForm(&gocui.Gui{}, "form", 0, 0, 0, 0)
+	country := f.AddInputField("Country", 8, 10)
+	state := f.AddInputField("State", 8, 10)
+	zip := f.AddInputField("Zip", 8, 10)
+	tax := f.AddInputField("Tax", 8, 10)
+
+	f.ShowIf(state, func(fd *FormData) bool {
+		return fd.GetFieldText("Country") == "US"
+	})
+	// zip depends on state which is hidden by the rule above
+	f.ShowIf(zip, func(fd *FormData) bool {
+		return fd.GetFieldText("State") != ""
+	})
+	f.EnableIf(tax, func(fd *FormData) bool {
+		return fd.GetFieldText("Country") == "JP"
+	})
+
+	tests := []struct {
+		country, state       string
+		changed              bool
+		stateShown, zipShown bool
+		taxEnabled           bool
+	}{
+		{country: "US", state: "CA", changed: true, stateShown: true, zipShown: true, taxEnabled: false},
+		{country: "JP", state: "CA", changed: true, stateShown: false, zipShown: false, taxEnabled: true},
+		{country: "JP", state: "CA", changed: false, stateShown: false, zipShown: false, taxEnabled: true},
+		{country: "US", state: "", changed: true, stateShown: true, zipShown: false, taxEnabled: false},
+	}
+
+	for _, tt := range tests {
+		country.SetText(tt.country)
+		state.SetText(tt.state)
+
+		if got := f.applyRules(); got != tt.changed {
+			t.Errorf("%s/%s: applyRules() = %v, want %v", tt.country, tt.state, got, tt.changed)
+		}
+		if got := f.IsVisible(state); got != tt.stateShown {
+			t.Errorf("%s/%s: State visible = %v, want %v", tt.country, tt.state, got, tt.stateShown)
+		}
+		if got := f.IsVisible(zip); got != tt.zipShown {
+			t.Errorf("%s/%s: Zip visible = %v, want %v", tt.country, tt.state, got, tt.zipShown)
+		}
+		if got := f.IsEnabled(tax); got != tt.taxEnabled {
+			t.Errorf("%s/%s: Tax enabled = %v, want %v", tt.country, tt.state, got, tt.taxEnabled)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package component
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jroimartin/gocui"
+)
+
+const (
+	scrollbarTrack = "│"
+	scrollbarThumb = "█"
+)
+
+// NextPage scroll form a page down and focus the first component in the page
+func (f *Form) NextPage(g *gocui.Gui, v *gocui.View) error {
+	return f.scrollPage(1)
+}
+
+// PrePage scroll form a page up and focus the first component in the page
+func (f *Form) PrePage(g *gocui.Gui, v *gocui.View) error {
+	return f.scrollPage(-1)
+}
+
+// IsScrollable return true if form is higher than the terminal
+func (f *Form) IsScrollable() bool {
+	return f.contentH > f.H
+}
+
+// fitHeight limit form height to the terminal and move components by scroll offset
+func (f *Form) fitHeight(maxY int) {
+	f.contentH = f.H
+
+	// frame of form is drawn under H
+	if limit := maxY - 2; f.H > limit && limit > f.Y+1 {
+		f.H = limit
+	}
+
+	if f.offset > f.contentH-f.H {
+		f.offset = f.contentH - f.H
+	}
+	if f.offset < 0 {
+		f.offset = 0
+	}
+
+	for _, c := range f.components {
+		// components in fieldset are moved with it
+		if f.sections[c] != nil {
+			continue
+		}
+
+		if p, ok := c.(placeable); ok && f.offset != 0 {
+			b := p.getBounds()
+			p.setPosition(b.X, b.Y-f.offset)
+		}
+
+		if fs, ok := c.(*Fieldset); ok {
+			fs.setClip(f.Y, f.H+1)
+		}
+	}
+}
+
+// inView component is in the visible area of form
+// fieldset is displayed if part of it is visible
+func (f *Form) inView(c Component) bool {
+	p, ok := c.(placeable)
+	if !ok {
+		return true
+	}
+
+	b := p.getBounds()
+	if c.GetType() == TypeFieldset {
+		return b.Y < f.H+1 && b.H > f.Y
+	}
+
+	return b.Y >= f.Y && b.H <= f.H+1
+}
+
+// scrollToItem scroll form to display the component
+func (f *Form) scrollToItem(index int) error {
+	if !f.IsScrollable() {
+		return nil
+	}
+
+	p, ok := f.components[index].(placeable)
+	if !ok {
+		return nil
+	}
+
+	b := p.getBounds()
+	offset := f.offset
+
+	// top of component is displayed if it is higher than form
+	switch {
+	case b.Y < f.Y:
+		offset -= f.Y - b.Y
+	case b.H > f.H+1:
+		offset += b.H - (f.H + 1)
+		if b.H-b.Y > f.H+1-f.Y {
+			offset -= b.H - b.Y - (f.H + 1 - f.Y)
+		}
+	}
+
+	return f.scrollTo(offset)
+}
+
+// scrollPage scroll form by pages and focus the first component in the page
+func (f *Form) scrollPage(n int) error {
+	if !f.IsScrollable() {
+		return nil
+	}
+
+	if err := f.scrollTo(f.offset + n*(f.H-f.Y)); err != nil {
+		return err
+	}
+
+	for _, index := range f.tabOrder {
+		c := f.components[index]
+		if f.canFocus(index) && f.inView(c) && c.GetPosition().Y >= f.Y {
+			f.components[f.activeItem].UnFocus()
+			f.activeItem = index
+			c.Focus()
+			break
+		}
+	}
+
+	return nil
+}
+
+// scrollTo scroll form to offset and draw it again
+func (f *Form) scrollTo(offset int) error {
+	if offset == f.offset {
+		return nil
+	}
+	f.offset = offset
+
+	if _, err := f.Gui.View(f.name); err != nil {
+		return nil
+	}
+
+	f.layout()
+	return f.draw()
+}
+
+// drawScrollbar draw scrollbar on the right frame of form if it is scrollable
+func (f *Form) drawScrollbar() error {
+	name := viewName(f.name, "scrollbar")
+	if !f.IsScrollable() {
+		return deleteView(f.Gui, name)
+	}
+
+	v, err := setView(f.Gui, name, f.W, f.Y, f.W+2, f.H+1)
+	if err != nil {
+		if err != gocui.ErrUnknownView {
+			return newViewError(name, err)
+		}
+
+		v.Frame = false
+	}
+
+	rows := f.H - f.Y
+	content := f.contentH - f.Y
+
+	size := rows * rows / content
+	if size < 1 {
+		size = 1
+	}
+	pos := f.offset * rows / content
+	if pos+size > rows {
+		pos = rows - size
+	}
+
+	lines := make([]string, rows)
+	for i := range lines {
+		lines[i] = scrollbarTrack
+		if i >= pos && i < pos+size {
+			lines[i] = scrollbarThumb
+		}
+	}
+
+	v.Clear()
+	fmt.Fprint(v, strings.Join(lines, "\n"))
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package component
+
+import (
+	"github.com/jroimartin/gocui"
+)
+
+const discardMessage = "Discard unsaved changes?"
+
+// OnSubmit set handler which is called with form data when form is submitted
+// ctrl+s submits form, and form is not submitted while it is invalid, call it before Draw
+// error is returned from the key handler, so gocui.ErrQuit quits the main loop
+func (f *Form) OnSubmit(handler func(fd *FormData) error) *Form {
+	f.onSubmit = handler
+	return f
+}
+
+// OnCancel set handler which is called after form is canceled and closed
+// Esc cancels form, and discarding changed values is confirmed on the modal manager of SetModalManager, call it before Draw
+func (f *Form) OnCancel(handler func() error) *Form {
+	f.onCancel = handler
+	return f
+}
+
+// SetModalManager set modal manager which displays confirm dialog of Cancel
+// use the manager which is laid out by gui, so that the dialog is centered when the terminal is resized
+func (f *Form) SetModalManager(manager *ModalManager) *Form {
+	f.modals = manager
+	return f
+}
+
+// Submit validate form and call submit handler
+// submitted values become the initial values of IsDirty
+func (f *Form) Submit(g *gocui.Gui, v *gocui.View) error {
+	if valid, err := f.ValidateErr(); !valid {
+		return err
+	}
+
+	fd := f.GetFormData()
+	if f.onSubmit != nil {
+		if err := f.onSubmit(fd); err != nil {
+			return err
+		}
+	}
+
+	f.initial = f.formData(true)
+
+	return nil
+}
+
+// Cancel close form and call cancel handler
+// if values are changed, it asks whether they are discarded
+// ErrNoModalManager is returned when values are changed and modal manager is not set
+func (f *Form) Cancel(g *gocui.Gui, v *gocui.View) error {
+	if !f.IsDirty() {
+		return f.cancel()
+	}
+
+	if f.modals == nil {
+		return ErrNoModalManager
+	}
+
+	confirm := NewConfirmDialog(f.modals, discardMessage).
+		OnResult(func(ok bool) error {
+			if !ok {
+				return nil
+			}
+			return f.cancel()
+		})
+
+	return confirm.Show()
+}
+
+// IsDirty return true if values are changed from the values when form was drawn first or submitted
+func (f *Form) IsDirty() bool {
+	if f.initial == nil {
+		return false
+	}
+	return len(f.initial.Diff(f.formData(true))) != 0
+}
+
+func (f *Form) cancel() error {
+	if err := f.Close(f.Gui, nil); err != nil {
+		return err
+	}
+
+	if f.onCancel != nil {
+		return f.onCancel()
+	}
+
+	return nil
+}
@@ -0,0 +1,280 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jroimartin/gocui"
+)
+
+// FormValidate validate form data
+// return errors with component key such as "Name" or "Network.Host" in fieldset
+type FormValidate func(*FormData) map[string]error
+
+type errorSummary struct {
+	name   string
+	labels []string
+}
+
+// AddValidate add form validator which can validate several fields
+func (f *Form) AddValidate(validate FormValidate) *Form {
+	f.validates = append(f.validates, validate)
+	return f
+}
+
+// SetErrorSummary display error summary when validation failed
+// the summary can be focused with ctrl+e, call it before Draw
+func (f *Form) SetErrorSummary(b bool) *Form {
+	if b {
+		f.summary = &errorSummary{
+			name: viewName(f.name, "errors"),
+		}
+	} else {
+		f.closeErrorSummary()
+		f.summary = nil
+	}
+
+	return f
+}
+
+// Validate validate form items and form validators
+// error which occurs when error messages or summary are displayed is returned from main loop
+func (f *Form) Validate() bool {
+	valid, err := f.ValidateErr()
+	sendError(f.Gui, err)
+	return valid
+}
+
+// ValidateErr validate form items and form validators
+// error is returned when error messages or summary cannot be displayed
+func (f *Form) ValidateErr() (bool, error) {
+	f.errors = map[string]error{}
+
+	for _, c := range f.components {
+		// user cannot fix value of hidden or disabled component
+		if !f.IsVisible(c) || !f.IsEnabled(c) {
+			continue
+		}
+
+		item, ok := c.(Validatable)
+		if !ok || item.Validate() {
+			continue
+		}
+
+		if h, ok := c.(validatorHolder); ok {
+			f.errors[f.keyOf(c)] = errors.New(h.getValidator().GetErrMsg())
+		} else {
+			f.errors[f.keyOf(c)] = errors.New("invalid value")
+		}
+	}
+
+	if len(f.validates) != 0 {
+		fd := f.GetFormData()
+		for _, validate := range f.validates {
+			for label, err := range validate(fd) {
+				if err == nil {
+					continue
+				}
+
+				// keep component error
+				if _, ok := f.errors[label]; ok {
+					continue
+				}
+
+				c := f.componentByLabel(label)
+				if c != nil && (!f.IsVisible(c) || !f.IsEnabled(c)) {
+					continue
+				}
+
+				f.errors[label] = err
+
+				if h, ok := c.(validatorHolder); ok {
+					if err := h.getValidator().SetError(err.Error()); err != nil {
+						return false, err
+					}
+				}
+			}
+		}
+	}
+
+	valid := len(f.errors) == 0
+
+	if f.summary != nil {
+		if !valid {
+			return valid, f.drawErrorSummary()
+		}
+		return valid, f.closeErrorSummary()
+	}
+
+	return valid, nil
+}
+
+// GetErrors get errors of last validation
+func (f *Form) GetErrors() map[string]error {
+	return f.errors
+}
+
+func (f *Form) componentByLabel(label string) Component {
+	for _, c := range f.components {
+		if f.keyOf(c) == label {
+			return c
+		}
+	}
+	return nil
+}
+
+func (f *Form) componentIndex(label string) int {
+	for i, c := range f.components {
+		if f.keyOf(c) == label {
+			return i
+		}
+	}
+	return -1
+}
+
+// drawErrorSummary draw failing fields at the right of form
+func (f *Form) drawErrorSummary() error {
+	s := f.summary
+
+	// form order, then errors which are not related to component
+	s.labels = s.labels[:0]
+	for _, c := range f.components {
+		if _, ok := f.errors[f.keyOf(c)]; ok {
+			s.labels = append(s.labels, f.keyOf(c))
+		}
+	}
+	for label := range f.errors {
+		if f.componentIndex(label) < 0 {
+			s.labels = append(s.labels, label)
+		}
+	}
+
+	lines := make([]string, len(s.labels))
+	width := len(s.name)
+	for i, label := range s.labels {
+		lines[i] = fmt.Sprintf("%s: %s", label, f.errors[label])
+		if len(lines[i]) > width {
+			width = len(lines[i])
+		}
+	}
+
+	x := f.W + 2
+	y := f.Y
+
+	v, err := setView(f.Gui, s.name, x, y, x+width+1, y+len(lines)+1)
+	if err != nil {
+		if err != gocui.ErrUnknownView {
+			return newViewError(s.name, err)
+		}
+
+		v.Title = "Errors"
+		v.FgColor = gocui.ColorRed
+		v.SelFgColor = gocui.ColorBlack
+		v.SelBgColor = gocui.ColorRed
+		v.Highlight = true
+
+		handlers := Handlers{
+			gocui.KeyArrowDown: f.nextError,
+			'j':                f.nextError,
+			gocui.KeyArrowUp:   f.preError,
+			'k':                f.preError,
+			gocui.KeyEnter:     f.jumpToError,
+			gocui.KeyEsc:       f.leaveErrorSummary,
+		}
+
+		if err := setKeybindings(f.Gui, s.name, handlers); err != nil {
+			return err
+		}
+	}
+
+	v.Clear()
+	v.SetOrigin(0, 0)
+	v.SetCursor(0, 0)
+	for _, line := range lines {
+		fmt.Fprintln(v, line)
+	}
+
+	return nil
+}
+
+// moveErrorSummary keep displayed summary at the right of form
+func (f *Form) moveErrorSummary() error {
+	if f.summary == nil {
+		return nil
+	}
+
+	v, err := f.Gui.View(f.summary.name)
+	if err != nil {
+		return nil
+	}
+
+	w, h := v.Size()
+	x := f.W + 2
+	y := f.Y
+
+	if _, err := setView(f.Gui, f.summary.name, x, y, x+w+1, y+h+1); err != nil {
+		return newViewError(f.summary.name, err)
+	}
+
+	return nil
+}
+
+func (f *Form) closeErrorSummary() error {
+	if f.summary == nil {
+		return nil
+	}
+
+	f.Gui.DeleteKeybindings(f.summary.name)
+	return deleteView(f.Gui, f.summary.name)
+}
+
+func (f *Form) focusErrorSummary(g *gocui.Gui, v *gocui.View) error {
+	if f.summary == nil {
+		return nil
+	}
+
+	if _, err := g.View(f.summary.name); err != nil {
+		return nil
+	}
+
+	f.components[f.activeItem].UnFocus()
+	g.Cursor = false
+	_, err := g.SetCurrentView(f.summary.name)
+	return err
+}
+
+func (f *Form) nextError(g *gocui.Gui, v *gocui.View) error {
+	_, cy := v.Cursor()
+	if cy+1 < len(f.summary.labels) {
+		v.SetCursor(0, cy+1)
+	}
+	return nil
+}
+
+func (f *Form) preError(g *gocui.Gui, v *gocui.View) error {
+	_, cy := v.Cursor()
+	if cy > 0 {
+		v.SetCursor(0, cy-1)
+	}
+	return nil
+}
+
+// jumpToError focus component of selected error
+func (f *Form) jumpToError(g *gocui.Gui, v *gocui.View) error {
+	_, cy := v.Cursor()
+	if cy >= len(f.summary.labels) {
+		return nil
+	}
+
+	if i := f.componentIndex(f.summary.labels[cy]); i >= 0 {
+		f.SetCurrentItem(i)
+		return nil
+	}
+
+	return f.leaveErrorSummary(g, v)
+}
+
+func (f *Form) leaveErrorSummary(g *gocui.Gui, v *gocui.View) error {
+	f.SetCurrentItem(f.activeItem)
+	return nil
+}
@@ -0,0 +1,85 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/jroimartin/gocui"
+)
+
+func TestRadioSetValueBeforeDraw(t *testing.T) {
+	var changes [][2]interface{}
+	r := NewRadio(&gocui.Gui{}, "Plan", 0, 0, 5).
+		AddOptions("Free", "Team").
+		OnChange(func(old, new interface{}) {
+			changes = append(changes, [2]interface{}{old, new})
+		})
+
+	if err := r.SetValue("Free"); err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("SetValue of checked option emitted %v", changes)
+	}
+
+	if err := r.SetValue("Team"); err != nil {
+		t.Fatal(err)
+	}
+	if want := [2]interface{}{"Free", "Team"}; len(changes) != 1 || changes[0] != want {
+		t.Errorf("SetValue emitted %v, want %v", changes, want)
+	}
+}
+
+func TestResetBeforeDraw(t *testing.T) {
+	f := NewForm(&gocui.Gui{}, "form", 0, 0, 0, 0)
+	name := f.AddInputField("Name", 5, 10).
+		AddValidates(Required())
+
+	if valid, err := f.ValidateErr(); valid || err != nil {
+		t.Fatalf("ValidateErr() = %v, %v, want invalid", valid, err)
+	}
+
+	if err := f.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.GetErrors()) != 0 || !name.IsValid() {
+		t.Errorf("Reset() kept errors %v", f.GetErrors())
+	}
+}
+
+func TestResetRestoresInitialValues(t *testing.T) {
+	f := NewForm(&gocui.Gui{}, "form", 0, 0, 0, 0)
+	name := f.AddInputField("Name", 5, 10).SetText("gorilla")
+	admin := f.AddCheckBox("Admin", 5)
+	plan := f.AddRadio("Plan", 5).AddOptions("Free", "Team")
+
+	f.initial = f.formData(true)
+
+	name.SetValue("zoo")
+	admin.SetValue(true)
+	plan.SetValue("Team")
+	if !f.IsDirty() {
+		t.Fatal("IsDirty() = false after values are changed")
+	}
+
+	if err := f.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if name.GetFieldText() != "gorilla" || admin.IsChecked() || plan.GetSelected() != "Free" {
+		t.Errorf("Reset() = %q, %v, %q", name.GetFieldText(), admin.IsChecked(), plan.GetSelected())
+	}
+	if f.IsDirty() {
+		t.Error("IsDirty() = true after Reset")
+	}
+}
+
+func TestCancelWithoutModalManager(t *testing.T) {
+	f := NewForm(&gocui.Gui{}, "form", 0, 0, 0, 0)
+	name := f.AddInputField("Name", 5, 10)
+
+	f.initial = f.formData(true)
+	name.SetValue("gorilla")
+
+	if err := f.Cancel(nil, nil); err != ErrNoModalManager {
+		t.Errorf("Cancel() = %v, want %v", err, ErrNoModalManager)
+	}
+}
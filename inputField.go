@@ -1,8 +1,11 @@
 package component
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/jroimartin/gocui"
 )
@@ -16,8 +19,12 @@ type Margin struct {
 // InputField struct
 type InputField struct {
 	*gocui.Gui
-	label *Label
-	field *Field
+	id       string
+	label    *Label
+	field    *Field
+	disabled bool
+	dimmed   bool
+	events
 }
 
 // Label struct
@@ -45,8 +52,6 @@ type Field struct {
 	*Validator
 }
 
-var labelPrefix = "label"
-
 // NewInputField new input label and field
 func NewInputField(gui *gocui.Gui, labelText string, x, y, labelWidth, fieldWidth int) *InputField {
 	gui.Cursor = true
@@ -97,7 +102,7 @@ func NewInputField(gui *gocui.Gui, labelText string, x, y, labelWidth, fieldWidt
 			top:  0,
 			left: 0,
 		},
-		Validator: NewValidator(gui, label.text+"validator", fp.X, fp.Y+1, fp.W, fp.H+1),
+		Validator: NewValidator(gui, viewName(labelText, "validator"), fp.X, fp.Y+1, fp.W, fp.H+1),
 		editable:  true,
 		ctype:     TypeInputField,
 	}
@@ -105,6 +110,7 @@ func NewInputField(gui *gocui.Gui, labelText string, x, y, labelWidth, fieldWidt
 	// new input field
 	i := &InputField{
 		Gui:   gui,
+		id:    labelText,
 		label: label,
 		field: field,
 	}
@@ -112,6 +118,19 @@ func NewInputField(gui *gocui.Gui, labelText string, x, y, labelWidth, fieldWidt
 	return i
 }
 
+// SetID set input field id which is used as view name
+// it must be called before Draw
+func (i *InputField) SetID(id string) *InputField {
+	i.id = id
+	i.field.Validator.name = viewName(id, "validator")
+	return i
+}
+
+// GetID get input field id
+func (i *InputField) GetID() string {
+	return i.id
+}
+
 // AddFieldAttribute add field colors
 func (i *InputField) AddFieldAttribute(textColor, textBgColor, fgColor, bgColor gocui.Attribute) *InputField {
 	i.field.Attributes = &Attributes{
@@ -159,6 +178,29 @@ func (i *InputField) AddValidate(errMsg string, validate func(value string) bool
 	return i
 }
 
+// AddAsyncValidate add validate which takes a long time such as checking server
+func (i *InputField) AddAsyncValidate(errMsg string, validate func(ctx context.Context, value string) bool) *InputField {
+	i.field.AddAsyncValidate(errMsg, validate)
+	return i
+}
+
+// SetValidateDebounce set wait time before async validation
+func (i *InputField) SetValidateDebounce(d time.Duration) *InputField {
+	i.field.SetDebounce(d)
+	return i
+}
+
+// IsPending if async validation is running return true
+func (i *InputField) IsPending() bool {
+	return i.field.Validator.IsPending()
+}
+
+// AddValidates add input validates such as Required()
+func (i *InputField) AddValidates(validates ...Validate) *InputField {
+	i.field.AddValidates(validates...)
+	return i
+}
+
 // SetLabelBorder draw label border
 func (i *InputField) SetLabelBorder() *InputField {
 	i.label.drawFrame = true
@@ -179,22 +221,55 @@ func (i *InputField) SetMask() *InputField {
 
 // SetMaskKeybinding set or unset input field to mask '*' with key
 func (i *InputField) SetMaskKeybinding(key Key) *InputField {
-	if err := i.Gui.SetKeybinding(i.label.text, key, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
+	return i.AddHandler(key, func(g *gocui.Gui, v *gocui.View) error {
 		v.Mask ^= '*'
 		return nil
-	}); err != nil {
-		panic(err)
-	}
-
-	return i
+	})
 }
 
 // SetText set text
+// displayed field is updated too
 func (i *InputField) SetText(text string) *InputField {
 	i.field.text = text
+
+	if v, err := i.Gui.View(i.id); err == nil {
+		v.Clear()
+		fmt.Fprint(v, text)
+		v.SetOrigin(0, 0)
+		v.SetCursor(utf8.RuneCountInString(text), 0)
+	}
+
 	return i
 }
 
+// SetValue set text, value must be string
+// change handler is called if text is changed
+func (i *InputField) SetValue(value interface{}) error {
+	text, ok := value.(string)
+	if !ok {
+		return invalidValue(i.id, value)
+	}
+
+	old := i.field.text
+	i.SetText(text)
+
+	// update error message which is displayed
+	if !i.IsValid() {
+		i.field.Validate(text)
+	}
+
+	if text != old {
+		return i.emitChange(old, text)
+	}
+
+	return nil
+}
+
+// GetValue get text
+func (i *InputField) GetValue() interface{} {
+	return i.field.text
+}
+
 // SetCursor set input field cursor
 func (i *InputField) SetCursor(b bool) *InputField {
 	i.Gui.Cursor = b
@@ -207,15 +282,58 @@ func (i *InputField) SetEditable(b bool) *InputField {
 	return i
 }
 
+// SetDisabled if b is true, input field is dimmed and its handlers are ignored
+func (i *InputField) SetDisabled(b bool) {
+	i.disabled = b
+	i.applyDisabled()
+	i.emitDisabled()
+}
+
+// IsDisabled return disabled state
+func (i *InputField) IsDisabled() bool {
+	return i.disabled
+}
+
+// setDimmed dim input field while its fieldset is disabled, its own disabled state is kept
+func (i *InputField) setDimmed(b bool) {
+	i.dimmed = b
+	i.applyDisabled()
+}
+
+// inactive return true if input field is disabled or dimmed
+func (i *InputField) inactive() bool {
+	return i.disabled || i.dimmed
+}
+
+// OnChange set handler which is called when text is changed
+func (i *InputField) OnChange(handler func(old, new interface{})) *InputField {
+	i.onChange = handler
+	return i
+}
+
+// OnFocus set handler which is called when input field gets focus
+func (i *InputField) OnFocus(handler func()) *InputField {
+	i.onFocus = handler
+	return i
+}
+
+// OnBlur set handler which is called when input field loses focus
+func (i *InputField) OnBlur(handler func()) *InputField {
+	i.onBlur = handler
+	return i
+}
+
 // Focus focus to input field
 func (i *InputField) Focus() {
 	i.Gui.Cursor = true
-	i.Gui.SetCurrentView(i.label.text)
+	i.Gui.SetCurrentView(i.id)
+	i.emitFocus()
 }
 
 // UnFocus un focus
 func (i *InputField) UnFocus() {
 	i.Gui.Cursor = false
+	i.emitBlur()
 }
 
 // Edit input field editor
@@ -234,10 +352,15 @@ func (i *InputField) Edit(v *gocui.View, key gocui.Key, ch rune, mod gocui.Modif
 	}
 
 	// get field text
+	old := i.field.text
 	i.field.text = i.cutNewline(v.Buffer())
 
 	// validate
 	i.field.Validate(i.GetFieldText())
+
+	if i.field.text != old {
+		sendError(i.Gui, i.emitChange(old, i.field.text))
+	}
 }
 
 // GetFieldText get input field text
@@ -272,12 +395,13 @@ func (i *InputField) GetType() ComponentType {
 }
 
 // Draw draw label and field
-func (i *InputField) Draw() {
+func (i *InputField) Draw() error {
 	// draw label
 	x, y, w, h := i.addMargin(i.label)
-	if v, err := i.Gui.SetView(labelPrefix+i.label.text, x, y, w, h); err != nil {
+	name := viewName(i.id, "label")
+	if v, err := setView(i.Gui, name, x, y, w, h); err != nil {
 		if err != gocui.ErrUnknownView {
-			panic(err)
+			return newViewError(name, err)
 		}
 
 		v.Frame = i.label.drawFrame
@@ -290,9 +414,9 @@ func (i *InputField) Draw() {
 
 	// draw input
 	x, y, w, h = i.addMargin(i.field)
-	if v, err := i.Gui.SetView(i.label.text, x, y, w, h); err != nil {
+	if v, err := setView(i.Gui, i.id, x, y, w, h); err != nil {
 		if err != gocui.ErrUnknownView {
-			panic(err)
+			return newViewError(i.id, err)
 		}
 
 		v.Frame = i.field.drawFrame
@@ -312,41 +436,41 @@ func (i *InputField) Draw() {
 		}
 
 		// focus input field
-		i.Focus()
-	}
+		i.Gui.Cursor = true
+		i.Gui.SetCurrentView(i.id)
 
-	// set keybindings
-	if i.field.handlers != nil {
-		for key, handler := range i.field.handlers {
-			if err := i.Gui.SetKeybinding(i.label.text, key, gocui.ModNone, handler); err != nil {
-				panic(err)
-			}
+		// set keybindings
+		if err := setKeybindings(i.Gui, i.id, ignoreDisabled(i.field.handlers, i.inactive)); err != nil {
+			return err
 		}
 	}
+
+	i.applyDisabled()
+
+	return nil
 }
 
 // Close close input field
-func (i *InputField) Close() {
+func (i *InputField) Close() error {
 	views := []string{
-		i.label.text,
-		labelPrefix + i.label.text,
+		i.id,
+		viewName(i.id, "label"),
 	}
 
 	for _, v := range views {
-		if err := i.DeleteView(v); err != nil {
-			if err != gocui.ErrUnknownView {
-				panic(err)
-			}
+		if err := deleteView(i.Gui, v); err != nil {
+			return err
 		}
 	}
 
-	if i.field.handlers != nil {
-		i.DeleteKeybindings(i.label.text)
-	}
+	i.DeleteKeybindings(i.id)
 
 	if i.field.Validator != nil {
-		i.field.Validator.CloseValidateMsg()
+		i.field.Validator.StopAsync()
+		return i.field.Validator.CloseValidateMsg()
 	}
+
+	return nil
 }
 
 func (i *InputField) addMargin(view interface{}) (int, int, int, int) {
@@ -364,10 +488,62 @@ func (i *InputField) addMargin(view interface{}) (int, int, int, int) {
 	}
 }
 
+// setPosition move label, field and error message to x, y
+func (i *InputField) setPosition(x, y int) {
+	dx, dy := x-i.label.X, y-i.label.Y
+	i.label.Position.move(dx, dy)
+	i.field.Position.move(dx, dy)
+	i.field.Validator.Position.move(dx, dy)
+}
+
+// getBounds get area of label and field
+func (i *InputField) getBounds() *Position {
+	return &Position{i.label.X, i.label.Y, i.field.W, i.field.H}
+}
+
+// setWidth shrink field to fit in width from the left of label
+// field is not wider than the width given to NewInputField
+func (i *InputField) setWidth(width int) {
+	fieldWidth := width - (i.field.X - i.label.X)
+	if fieldWidth > i.field.width {
+		fieldWidth = i.field.width
+	}
+	if fieldWidth < 1 {
+		fieldWidth = 1
+	}
+
+	i.field.W = i.field.X + fieldWidth
+}
+
+// applyDisabled change colors of displayed label and field by disabled state
+func (i *InputField) applyDisabled() {
+	if v, err := i.Gui.View(viewName(i.id, "label")); err == nil {
+		v.FgColor = i.label.textColor | gocui.AttrBold
+		if i.inactive() {
+			v.FgColor = disabledTextColor
+		}
+	}
+
+	if v, err := i.Gui.View(i.id); err == nil {
+		v.FgColor = i.field.textColor
+		v.BgColor = i.field.textBgColor
+		v.Editable = i.field.editable
+		if i.inactive() {
+			v.FgColor = disabledFieldColor
+			v.BgColor = disabledFieldBgColor
+			v.Editable = false
+		}
+	}
+}
+
 func (i *InputField) cutNewline(text string) string {
 	return strings.Replace(text, "\n", "", -1)
 }
 
+func (i *InputField) getValidator() *Validator {
+	return i.field.Validator
+}
+
 // AddHandlerOnly add handler not return
 func (i *InputField) AddHandlerOnly(key Key, handler Handler) {
 	i.AddHandler(key, handler)
@@ -2,10 +2,10 @@ package component
 
 import (
 	"fmt"
-	"math"
 	"strings"
 
 	"github.com/jroimartin/gocui"
+	runewidth "github.com/mattn/go-runewidth"
 )
 
 // Modal struct
@@ -15,69 +15,113 @@ type Modal struct {
 	textArea     *textArea
 	activeButton int
 	buttons      []*Button
+	defaultBtn   *Button
+	input        *InputField
+	escToClose   bool
+	manager      *ModalManager
+	search       *modalSearch
+	x, y         int
+	width        int
+	center       bool
 	*Attributes
 	*Position
 }
 
 type textArea struct {
 	*gocui.Gui
-	name string
-	text string
+	name   string
+	text   string
+	lines  []string
+	offset int
+	match  int
 	*Attributes
 	*Position
 }
 
+// modalSearch state of searching text
+type modalSearch struct {
+	from   string
+	cursor bool
+}
+
+const indicatorWidth = 14
+
+// modalCount number of created modals, it is used for unique modal name
+var modalCount int
+
 // NewModal new modal
 func NewModal(gui *gocui.Gui, x, y, w int) *Modal {
-	p := &Position{
-		X: x,
-		Y: y,
-		W: w,
-		H: y + 3,
-	}
+	modalCount++
+	name := fmt.Sprintf("modal%d", modalCount)
 
-	return &Modal{
+	m := &Modal{
 		Gui:          gui,
-		name:         "modal",
+		name:         name,
 		activeButton: 0,
+		escToClose:   true,
+		x:            x,
+		y:            y,
+		width:        w - x,
 		Attributes: &Attributes{
 			textColor:   gocui.ColorWhite,
 			textBgColor: gocui.ColorBlue,
 		},
-		Position: p,
+		Position: &Position{
+			X: x,
+			Y: y,
+		},
 		textArea: &textArea{
-			Gui:  gui,
-			name: "textArea",
+			Gui:   gui,
+			name:  viewName(name, "text"),
+			match: -1,
 			Attributes: &Attributes{
 				textColor:   gocui.ColorWhite,
 				textBgColor: gocui.ColorBlue,
 			},
-			Position: &Position{
-				X: p.X + 1,
-				Y: p.Y + 1,
-				W: p.W - 1,
-				H: p.H - 1,
-			},
+			Position: &Position{},
 		},
 	}
+
+	m.layout()
+
+	return m
+}
+
+// NewCenterModal new modal which is centered in the terminal
+func NewCenterModal(gui *gocui.Gui, width int) *Modal {
+	return NewModal(gui, 0, 0, width).SetCenter(true)
+}
+
+// SetCenter if b is true, modal is centered in the terminal
+// use Layout to keep it centered when the terminal is resized
+func (m *Modal) SetCenter(b bool) *Modal {
+	m.center = b
+	m.layout()
+	return m
 }
 
 // SetText set text
+// height of modal is computed from the wrapped text
 func (m *Modal) SetText(text string) *Modal {
 	m.textArea.text = text
-	h := int(roundUp(float64(len(text)/(m.W-m.X)), 0))
-
-	newLineCount := strings.Count(text, "\n")
-	if newLineCount > h {
-		h = newLineCount
-	}
-
-	m.textArea.H += h
-	m.H += h
+	m.textArea.offset = 0
+	m.textArea.match = -1
+	m.layout()
+	return m
+}
 
+// SetEscToClose if b is true the modal is closed with Esc
+// it works when the modal is opened with ModalManager
+func (m *Modal) SetEscToClose(b bool) *Modal {
+	m.escToClose = b
 	return m
 }
 
+// GetName get modal name
+func (m *Modal) GetName() string {
+	return m.name
+}
+
 // SetTextColor set text color
 func (m *Modal) SetTextColor(textColor gocui.Attribute) *Modal {
 	m.textArea.textColor = textColor
@@ -85,110 +129,620 @@ func (m *Modal) SetTextColor(textColor gocui.Attribute) *Modal {
 }
 
 // AddButton add button
+// buttons are placed from right to left
 func (m *Modal) AddButton(label string, key Key, handler Handler) *Button {
-	var x, y, w int
-	if len(m.buttons) == 0 {
-		w = m.W - 5
-		x = w - len(label)
-		y = m.H - 1
-		m.H += 2
-	} else {
-		p := m.buttons[len(m.buttons)-1].GetPosition()
-		w = p.W - 10
-		x = w - len(label)
-		y = p.Y
-	}
-
-	button := NewButton(m.Gui, label, x, y, len(label)).
-		AddHandler(gocui.KeyTab, m.nextButton).
-		AddHandler(key, handler).
+	button := NewButton(m.Gui, label, 0, 0, len(label)).
+		SetID(scopedID(m.name, label)).
+		AddHandler(gocui.KeyTab, m.nextButton)
+
+	for k, h := range m.scrollHandlers() {
+		button.AddHandler(k, h)
+	}
+
+	button.AddHandler(key, handler).
 		SetTextColor(gocui.ColorWhite, gocui.ColorBlack).
 		SetHilightColor(gocui.ColorBlack, gocui.ColorWhite)
 
 	m.buttons = append(m.buttons, button)
+	m.layout()
+
 	return button
 }
 
+// SetDefaultButton set button which is focused when modal is drawn
+// the last added button is focused by default
+func (m *Modal) SetDefaultButton(button *Button) *Modal {
+	m.defaultBtn = button
+	return m
+}
+
+// defaultButton get index of button which is focused first
+func (m *Modal) defaultButton() int {
+	for i, b := range m.buttons {
+		if b == m.defaultBtn {
+			return i
+		}
+	}
+	return len(m.buttons) - 1
+}
+
+// AddInputField add input field under the text
+func (m *Modal) AddInputField(label string, labelWidth, fieldWidth int) *InputField {
+	m.input = NewInputField(m.Gui, label, 0, 0, labelWidth, fieldWidth).
+		SetID(scopedID(m.name, label)).
+		AddLabelAttribute(m.textArea.textColor, m.textArea.textBgColor).
+		AddHandler(gocui.KeyTab, m.focusButton).
+		AddHandler(gocui.KeyArrowUp, m.scrollUp).
+		AddHandler(gocui.KeyArrowDown, m.scrollDown).
+		AddHandler(gocui.KeyPgup, m.pageUp).
+		AddHandler(gocui.KeyPgdn, m.pageDown)
+
+	m.layout()
+
+	return m.input
+}
+
+// GetInputField get input field, return nil if it is not added
+func (m *Modal) GetInputField() *InputField {
+	return m.input
+}
+
 // GetPosition get modal position
 func (m *Modal) GetPosition() *Position {
 	return m.Position
 }
 
 // Draw draw modal
-func (m *Modal) Draw() {
+func (m *Modal) Draw() error {
+	m.layout()
+
+	if err := m.draw(); err != nil {
+		return err
+	}
+
+	if len(m.buttons) != 0 {
+		m.activeButton = m.defaultButton()
+	}
+
+	if m.input != nil {
+		m.input.Focus()
+	} else if len(m.buttons) != 0 {
+		m.buttons[m.activeButton].Focus()
+	}
+
+	return nil
+}
+
+// Layout recompute position and redraw modal if it is displayed
+// it implements gocui.Manager
+func (m *Modal) Layout(g *gocui.Gui) error {
+	if _, err := g.View(m.name); err != nil {
+		return nil
+	}
+
+	m.layout()
+	return m.draw()
+}
+
+func (m *Modal) draw() error {
 	// modal
-	if v, err := m.Gui.SetView(m.name, m.X, m.Y, m.W, m.H); err != nil {
+	if v, err := setView(m.Gui, m.name, m.X, m.Y, m.W, m.H); err != nil {
 		if err != gocui.ErrUnknownView {
-			panic(err)
+			return newViewError(m.name, err)
 		}
 
 		v.Frame = false
 		v.FgColor = m.textColor
 		v.BgColor = m.textBgColor
+
+		// modal is focused when it has no buttons
+		if err := setKeybindings(m.Gui, m.name, m.scrollHandlers()); err != nil {
+			return err
+		}
 	}
 
 	// text area
 	area := m.textArea
 	if area.text != "" {
-		if v, err := area.Gui.SetView(area.name, area.X, area.Y, area.W, area.H); err != nil {
+		v, err := setView(area.Gui, area.name, area.X, area.Y, area.W, area.H)
+		if err != nil {
 			if err != gocui.ErrUnknownView {
-				panic(err)
+				return newViewError(area.name, err)
 			}
 
-			v.Wrap = true
 			v.Frame = false
 
 			v.FgColor = area.textColor
 			v.BgColor = area.textBgColor
+			v.SelFgColor = area.textBgColor
+			v.SelBgColor = area.textColor
+		}
+
+		// text is wrapped by modal to compute height with display width
+		v.Clear()
+		fmt.Fprint(v, strings.Join(area.lines, "\n"))
+		if err := m.showMatch(v); err != nil {
+			return newViewError(area.name, err)
+		}
+	}
+
+	if err := m.drawIndicator(""); err != nil {
+		return err
+	}
 
-			fmt.Fprint(v, area.text)
+	// search field follows the modal when it is moved
+	if m.search != nil {
+		name := viewName(m.name, "search")
+		if _, err := setView(m.Gui, name, m.X, m.H-2, m.indicatorX(), m.H); err != nil {
+			return newViewError(name, err)
+		}
+	}
+
+	// input field
+	if m.input != nil {
+		if err := m.input.Draw(); err != nil {
+			return err
 		}
 	}
 
 	// button
 	for _, b := range m.buttons {
-		b.Draw()
+		if err := b.Draw(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// layout compute position of modal and its parts
+// modal is clamped to the terminal and the text is scrolled if it is too long
+func (m *Modal) layout() {
+	maxX, maxY := m.Gui.Size()
+
+	width := m.width
+	if width > maxX-1 {
+		width = maxX - 1
+	}
+	if width < 10 {
+		width = 10
 	}
 
+	m.X = m.x
+	if m.center {
+		m.X = (maxX - width) / 2
+	}
+	if m.X+width > maxX-1 {
+		m.X = maxX - 1 - width
+	}
+	if m.X < 0 {
+		m.X = 0
+	}
+	m.W = m.X + width
+
+	// modal border, blank line under the text, input field and buttons
+	rest := 3
+	if m.input != nil {
+		rest += 3
+	}
 	if len(m.buttons) != 0 {
-		m.activeButton = len(m.buttons) - 1
-		m.buttons[m.activeButton].Focus()
+		rest += 2
+	}
+
+	area := m.textArea
+	area.lines = wrapText(area.text, width-3)
+
+	textHeight := len(area.lines)
+	if limit := maxY - 1 - rest; textHeight > limit {
+		textHeight = limit
+	}
+	if textHeight < 1 && area.text != "" {
+		textHeight = 1
+	}
+
+	height := textHeight + rest
+	m.Y = m.y
+	if m.center {
+		m.Y = (maxY - height) / 2
+	}
+	if m.Y+height > maxY-1 {
+		m.Y = maxY - 1 - height
+	}
+	if m.Y < 0 {
+		m.Y = 0
+	}
+	m.H = m.Y + height
+
+	area.X = m.X + 1
+	area.Y = m.Y + 1
+	area.W = m.W - 1
+	area.H = m.Y + 2 + textHeight
+	if limit := len(area.lines) - textHeight; area.offset > limit {
+		area.offset = limit
+	}
+
+	y := area.H
+	if m.input != nil {
+		m.input.setPosition(m.X+2, y)
+		y += 3
+	}
+
+	right := m.W - 5
+	for _, b := range m.buttons {
+		b.setPosition(right-len(b.label), y)
+		right = b.W - 10
 	}
 }
 
 // Close close modal
-func (m *Modal) Close() {
-	if err := m.DeleteView(m.name); err != nil {
-		if err != gocui.ErrUnknownView {
-			panic(err)
+// if the modal is opened with ModalManager, focus returns to the previous view
+func (m *Modal) Close() error {
+	if m.manager != nil {
+		return m.manager.remove(m)
+	}
+	return m.close()
+}
+
+func (m *Modal) close() error {
+	m.DeleteKeybindings(m.name)
+	if err := deleteView(m.Gui, m.name); err != nil {
+		return err
+	}
+
+	if err := deleteView(m.Gui, m.textArea.name); err != nil {
+		return err
+	}
+
+	if m.input != nil {
+		if err := m.input.Close(); err != nil {
+			return err
 		}
 	}
 
-	if err := m.DeleteView(m.textArea.name); err != nil {
-		if err != gocui.ErrUnknownView {
-			panic(err)
+	for _, name := range []string{viewName(m.name, "search"), viewName(m.name, "indicator")} {
+		m.DeleteKeybindings(name)
+		if err := deleteView(m.Gui, name); err != nil {
+			return err
 		}
 	}
+	m.search = nil
 
 	for _, b := range m.buttons {
-		b.Close()
+		if err := b.Close(); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
 // nextButton focus netxt button
 func (m *Modal) nextButton(g *gocui.Gui, v *gocui.View) error {
 	m.buttons[m.activeButton].UnFocus()
 	m.activeButton = (m.activeButton + 1) % len(m.buttons)
+
+	// input field is focused after all buttons
+	if m.input != nil && m.activeButton == len(m.buttons)-1 {
+		m.input.Focus()
+		return nil
+	}
+
 	m.buttons[m.activeButton].Focus()
 	return nil
 }
 
-func roundUp(num, places float64) float64 {
-	shift := math.Pow(10, places)
-	return roundUpInt(num*shift) / shift
+// focusButton focus button from input field
+func (m *Modal) focusButton(g *gocui.Gui, v *gocui.View) error {
+	if len(m.buttons) == 0 {
+		return nil
+	}
+
+	m.input.UnFocus()
+	m.activeButton = len(m.buttons) - 1
+	m.buttons[m.activeButton].Focus()
+	return nil
 }
 
-func roundUpInt(num float64) float64 {
-	t := math.Trunc(num)
-	return t + math.Copysign(1, num)
+// addHandlerOnly add handler to buttons and input field which do not use the key
+func (m *Modal) addHandlerOnly(key Key, handler Handler) {
+	for _, b := range m.buttons {
+		if _, ok := b.handlers[key]; !ok {
+			b.AddHandlerOnly(key, handler)
+		}
+	}
+
+	if m.input != nil {
+		if _, ok := m.input.field.handlers[key]; !ok {
+			m.input.AddHandlerOnly(key, handler)
+		}
+	}
+}
+
+// scrollHandlers keys to scroll and search text
+func (m *Modal) scrollHandlers() Handlers {
+	return Handlers{
+		gocui.KeyArrowUp:   m.scrollUp,
+		gocui.KeyArrowDown: m.scrollDown,
+		gocui.KeyPgup:      m.pageUp,
+		gocui.KeyPgdn:      m.pageDown,
+		gocui.KeyHome:      m.scrollTop,
+		gocui.KeyEnd:       m.scrollBottom,
+		'/':                m.openSearch,
+	}
+}
+
+// scrollUp scroll text up a line
+func (m *Modal) scrollUp(g *gocui.Gui, v *gocui.View) error {
+	return m.scrollTo(m.textArea.offset - 1)
+}
+
+// scrollDown scroll text down a line
+func (m *Modal) scrollDown(g *gocui.Gui, v *gocui.View) error {
+	return m.scrollTo(m.textArea.offset + 1)
+}
+
+// pageUp scroll text up a page
+func (m *Modal) pageUp(g *gocui.Gui, v *gocui.View) error {
+	return m.scrollTo(m.textArea.offset - m.textHeight())
+}
+
+// pageDown scroll text down a page
+func (m *Modal) pageDown(g *gocui.Gui, v *gocui.View) error {
+	return m.scrollTo(m.textArea.offset + m.textHeight())
+}
+
+// scrollTop scroll to the first line
+func (m *Modal) scrollTop(g *gocui.Gui, v *gocui.View) error {
+	return m.scrollTo(0)
+}
+
+// scrollBottom scroll to the last line
+func (m *Modal) scrollBottom(g *gocui.Gui, v *gocui.View) error {
+	return m.scrollTo(len(m.textArea.lines))
+}
+
+// scrollTo scroll text so that the line is at the top
+func (m *Modal) scrollTo(offset int) error {
+	area := m.textArea
+
+	if limit := len(area.lines) - m.textHeight(); offset > limit {
+		offset = limit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	area.offset = offset
+
+	if v, err := m.Gui.View(area.name); err == nil {
+		if err := m.showMatch(v); err != nil {
+			return newViewError(area.name, err)
+		}
+	}
+
+	return m.drawIndicator("")
+}
+
+// showMatch set origin of text view and highlight the matched line
+func (m *Modal) showMatch(v *gocui.View) error {
+	area := m.textArea
+
+	if err := v.SetOrigin(0, area.offset); err != nil {
+		return err
+	}
+
+	row := area.match - area.offset
+	v.Highlight = area.match >= 0 && row >= 0 && row < m.textHeight()
+	if v.Highlight {
+		return v.SetCursor(0, row)
+	}
+
+	return nil
+}
+
+// textHeight get number of displayed text lines
+func (m *Modal) textHeight() int {
+	if m.textArea.text == "" {
+		return 0
+	}
+	return m.textArea.H - m.textArea.Y - 1
+}
+
+// drawIndicator display scroll position at the bottom right of modal
+// msg is displayed instead of position if it is not empty
+func (m *Modal) drawIndicator(msg string) error {
+	area := m.textArea
+	name := viewName(m.name, "indicator")
+
+	if msg == "" && len(area.lines) <= m.textHeight() {
+		return deleteView(m.Gui, name)
+	}
+
+	if msg == "" {
+		last := area.offset + m.textHeight()
+		msg = fmt.Sprintf("%d-%d/%d", area.offset+1, last, len(area.lines))
+	}
+
+	v, err := setView(m.Gui, name, m.indicatorX(), m.H-2, m.W, m.H)
+	if err != nil {
+		if err != gocui.ErrUnknownView {
+			return newViewError(name, err)
+		}
+
+		v.Frame = false
+		v.FgColor = m.textColor | gocui.AttrBold
+		v.BgColor = m.textBgColor
+	}
+
+	v.Clear()
+	fmt.Fprintf(v, "%*s", m.W-m.indicatorX()-1, msg)
+
+	return nil
+}
+
+// indicatorX get left edge of indicator, it takes half of narrow modal
+func (m *Modal) indicatorX() int {
+	if x := m.X + (m.W-m.X)/2; x > m.W-indicatorWidth {
+		return x
+	}
+	return m.W - indicatorWidth
+}
+
+// openSearch display search field at the bottom of modal
+func (m *Modal) openSearch(g *gocui.Gui, v *gocui.View) error {
+	if m.textArea.text == "" {
+		return nil
+	}
+
+	name := viewName(m.name, "search")
+	sv, err := setView(g, name, m.X, m.H-2, m.indicatorX(), m.H)
+	if err != nil {
+		if err != gocui.ErrUnknownView {
+			return newViewError(name, err)
+		}
+
+		sv.Frame = false
+		sv.Editable = true
+		sv.FgColor = m.textColor
+		sv.BgColor = m.textBgColor
+
+		handlers := Handlers{
+			gocui.KeyEnter: m.searchNext,
+			gocui.KeyEsc:   m.closeSearch,
+		}
+		if err := setKeybindings(g, name, handlers); err != nil {
+			return err
+		}
+	}
+
+	m.search = &modalSearch{
+		from:   v.Name(),
+		cursor: g.Cursor,
+	}
+
+	sv.Clear()
+	fmt.Fprint(sv, "/")
+	if err := sv.SetCursor(1, 0); err != nil {
+		return newViewError(name, err)
+	}
+
+	g.Cursor = true
+	if _, err := g.SetCurrentView(name); err != nil {
+		return newViewError(name, err)
+	}
+
+	return nil
+}
+
+// searchNext scroll to the next line which contains the query
+func (m *Modal) searchNext(g *gocui.Gui, v *gocui.View) error {
+	query := strings.TrimPrefix(strings.TrimSpace(v.Buffer()), "/")
+	if query == "" {
+		return nil
+	}
+
+	area := m.textArea
+	count := len(area.lines)
+	start := area.match + 1
+	if area.match < 0 {
+		start = area.offset
+	}
+
+	for i := 0; i < count; i++ {
+		line := (start + i) % count
+		if strings.Contains(area.lines[line], query) {
+			area.match = line
+			if line < area.offset || line >= area.offset+m.textHeight() {
+				return m.scrollTo(line)
+			}
+			return m.scrollTo(area.offset)
+		}
+	}
+
+	area.match = -1
+	if err := m.scrollTo(area.offset); err != nil {
+		return err
+	}
+
+	return m.drawIndicator("not found")
+}
+
+// closeSearch close search field and focus the view which opened it
+func (m *Modal) closeSearch(g *gocui.Gui, v *gocui.View) error {
+	name := viewName(m.name, "search")
+	g.DeleteKeybindings(name)
+	if err := deleteView(g, name); err != nil {
+		return err
+	}
+
+	m.textArea.match = -1
+	if err := m.scrollTo(m.textArea.offset); err != nil {
+		return err
+	}
+
+	if m.search == nil {
+		return nil
+	}
+
+	g.Cursor = m.search.cursor
+	from := m.search.from
+	m.search = nil
+
+	if _, err := g.SetCurrentView(from); err != nil {
+		return newViewError(from, err)
+	}
+
+	return nil
+}
+
+// wrapText split text into lines which fit width on display
+// lines are broken at spaces if possible
+func wrapText(text string, width int) []string {
+	if text == "" {
+		return nil
+	}
+	if width < 1 {
+		width = 1
+	}
+
+	var lines []string
+	for _, para := range strings.Split(text, "\n") {
+		runes := []rune(para)
+		for {
+			n, w, space := 0, 0, -1
+			for n < len(runes) {
+				rw := runewidth.RuneWidth(runes[n])
+				if w+rw > width {
+					break
+				}
+				if runes[n] == ' ' {
+					space = n
+				}
+				w += rw
+				n++
+			}
+
+			if n == len(runes) {
+				lines = append(lines, string(runes))
+				break
+			}
+
+			// at least one rune in a line
+			if n == 0 {
+				n = 1
+			}
+
+			if space > 0 {
+				lines = append(lines, string(runes[:space]))
+				runes = runes[space+1:]
+			} else {
+				lines = append(lines, string(runes[:n]))
+				runes = runes[n:]
+				if runes[0] == ' ' {
+					runes = runes[1:]
+				}
+			}
+		}
+	}
+
+	return lines
 }
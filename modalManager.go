@@ -0,0 +1,206 @@
+package component
+
+import (
+	"github.com/jroimartin/gocui"
+)
+
+// ModalManager manage stacked modals
+type ModalManager struct {
+	*gocui.Gui
+	layers        []*modalLayer
+	backdrop      bool
+	backdropColor gocui.Attribute
+}
+
+// modalLayer opened modal and focus before it was opened
+type modalLayer struct {
+	modal  *Modal
+	view   string
+	cursor bool
+}
+
+// NewModalManager new modal manager
+// views under the top modal are covered with backdrop, see SetBackdrop
+func NewModalManager(gui *gocui.Gui) *ModalManager {
+	return &ModalManager{
+		Gui:           gui,
+		backdrop:      true,
+		backdropColor: gocui.ColorBlack,
+	}
+}
+
+// SetBackdrop if b is true, views under the top modal are covered
+// and mouse events do not reach them, it is true by default
+func (mm *ModalManager) SetBackdrop(b bool) *ModalManager {
+	mm.backdrop = b
+	return mm
+}
+
+// SetBackdropColor set backdrop color
+func (mm *ModalManager) SetBackdropColor(color gocui.Attribute) *ModalManager {
+	mm.backdropColor = color
+	return mm
+}
+
+// Push open modal on top of the current modals
+func (mm *ModalManager) Push(m *Modal) error {
+	layer := &modalLayer{
+		modal:  m,
+		cursor: mm.Cursor,
+	}
+	if v := mm.CurrentView(); v != nil {
+		layer.view = v.Name()
+	}
+
+	if mm.backdrop {
+		if err := mm.drawBackdrop(m); err != nil {
+			return err
+		}
+	}
+
+	if m.escToClose {
+		m.addHandlerOnly(gocui.KeyEsc, mm.dismiss)
+	}
+
+	if err := m.Draw(); err != nil {
+		return err
+	}
+
+	// modal without buttons and input field gets focus itself
+	if len(m.buttons) == 0 && m.input == nil {
+		mm.Cursor = false
+		if _, err := mm.SetCurrentView(m.name); err != nil {
+			return newViewError(m.name, err)
+		}
+	}
+
+	if m.escToClose {
+		if err := setKeybindings(mm.Gui, m.name, Handlers{gocui.KeyEsc: mm.dismiss}); err != nil {
+			return err
+		}
+	}
+
+	m.manager = mm
+	mm.layers = append(mm.layers, layer)
+
+	return nil
+}
+
+// Pop close the top modal and focus the view which was focused before it was opened
+func (mm *ModalManager) Pop() error {
+	if len(mm.layers) == 0 {
+		return nil
+	}
+
+	return mm.remove(mm.layers[len(mm.layers)-1].modal)
+}
+
+// Top get the top modal, return nil if there is no modal
+func (mm *ModalManager) Top() *Modal {
+	if len(mm.layers) == 0 {
+		return nil
+	}
+
+	return mm.layers[len(mm.layers)-1].modal
+}
+
+// Len get number of opened modals
+func (mm *ModalManager) Len() int {
+	return len(mm.layers)
+}
+
+// CloseAll close all modals
+func (mm *ModalManager) CloseAll() error {
+	for len(mm.layers) != 0 {
+		if err := mm.Pop(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// remove close modal and remove it from stack
+func (mm *ModalManager) remove(m *Modal) error {
+	index := -1
+	for i, layer := range mm.layers {
+		if layer.modal == m {
+			index = i
+			break
+		}
+	}
+
+	if index < 0 {
+		return m.close()
+	}
+
+	layer := mm.layers[index]
+	mm.layers = append(mm.layers[:index], mm.layers[index+1:]...)
+	m.manager = nil
+
+	// the modal above returns focus to where the removed modal returns
+	if index < len(mm.layers) {
+		mm.layers[index].view = layer.view
+		mm.layers[index].cursor = layer.cursor
+	}
+
+	if err := m.close(); err != nil {
+		return err
+	}
+
+	if err := deleteView(mm.Gui, viewName(m.name, "backdrop")); err != nil {
+		return err
+	}
+
+	if index < len(mm.layers) || layer.view == "" {
+		return nil
+	}
+
+	mm.Cursor = layer.cursor
+	if _, err := mm.SetCurrentView(layer.view); err != nil && err != gocui.ErrUnknownView {
+		return newViewError(layer.view, err)
+	}
+
+	return nil
+}
+
+// Layout recompute position of modals such as when the terminal is resized
+// it implements gocui.Manager
+//
+//	gui.SetManager(manager)
+func (mm *ModalManager) Layout(g *gocui.Gui) error {
+	for _, layer := range mm.layers {
+		if mm.backdrop {
+			if err := mm.drawBackdrop(layer.modal); err != nil {
+				return err
+			}
+		}
+
+		if err := layer.modal.Layout(g); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (mm *ModalManager) dismiss(g *gocui.Gui, v *gocui.View) error {
+	return mm.Pop()
+}
+
+func (mm *ModalManager) drawBackdrop(m *Modal) error {
+	name := viewName(m.name, "backdrop")
+	maxX, maxY := mm.Size()
+
+	v, err := setView(mm.Gui, name, -1, -1, maxX, maxY)
+	if err != nil {
+		if err != gocui.ErrUnknownView {
+			return newViewError(name, err)
+		}
+
+		v.Frame = false
+		v.BgColor = mm.backdropColor
+	}
+
+	return nil
+}
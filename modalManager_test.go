@@ -0,0 +1,36 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/jroimartin/gocui"
+)
+
+func TestModalManagerBackdrop(t *testing.T) {
+	g := &gocui.Gui{}
+	m := NewModal(g, 0, 0, 30).SetText("hello")
+	name := viewName(m.name, "backdrop")
+
+	mm := NewModalManager(g)
+	if err := mm.Push(m); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.View(name); err != nil {
+		t.Errorf("backdrop is not drawn by default: %v", err)
+	}
+
+	if err := mm.Pop(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.View(name); err == nil {
+		t.Error("backdrop is not deleted")
+	}
+
+	mm.SetBackdrop(false)
+	if err := mm.Push(m); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.View(name); err == nil {
+		t.Error("backdrop is drawn after SetBackdrop(false)")
+	}
+}
@@ -0,0 +1,30 @@
+package component
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWrapText(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		width int
+		want  []string
+	}{
+		{"empty", "", 10, nil},
+		{"fit", "hello", 10, []string{"hello"}},
+		{"at space", "hello world", 8, []string{"hello", "world"}},
+		{"long word", "abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+		{"space after break", "abcd efgh", 4, []string{"abcd", "efgh"}},
+		{"newline", "a\n\nb", 10, []string{"a", "", "b"}},
+		{"wide runes", "日本語です", 5, []string{"日本", "語で", "す"}},
+		{"zero width", "ab", 0, []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		if got := wrapText(tt.text, tt.width); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: wrapText(%q, %d) = %q, want %q", tt.name, tt.text, tt.width, got, tt.want)
+		}
+	}
+}
@@ -24,12 +24,18 @@ const (
 // Radio struct
 type Radio struct {
 	*gocui.Gui
-	label    string
-	active   int
-	options  []*option
-	handlers Handlers
-	ctype    ComponentType
-	mode     Mode
+	id        string
+	label     string
+	active    int
+	checked   int
+	options   []*option
+	handlers  Handlers
+	ctype     ComponentType
+	mode      Mode
+	validator *Validator
+	disabled  bool
+	dimmed    bool
+	events
 	*Position
 	*Attributes
 }
@@ -53,12 +59,14 @@ func NewRadio(gui *gocui.Gui, label string, x, y, w int) *Radio {
 	}
 
 	r := &Radio{
-		Gui:      gui,
-		label:    label,
-		handlers: make(Handlers),
-		ctype:    TypeRadio,
-		mode:     VerticalMode,
-		Position: p,
+		Gui:       gui,
+		id:        label,
+		label:     label,
+		handlers:  make(Handlers),
+		ctype:     TypeRadio,
+		mode:      VerticalMode,
+		validator: NewValidator(gui, viewName(label, "validator"), p.W, p.Y, p.W, p.H),
+		Position:  p,
 		Attributes: &Attributes{
 			textColor:   gocui.ColorYellow | gocui.AttrBold,
 			textBgColor: gocui.ColorDefault,
@@ -156,14 +164,77 @@ func (r *Radio) addOptionWithMode(name string, mode Mode) *Radio {
 	return r
 }
 
+// SetID set radio id which is used as view name
+// it must be called before Draw
+func (r *Radio) SetID(id string) *Radio {
+	r.id = id
+	r.validator.name = viewName(id, "validator")
+	return r
+}
+
+// GetID get radio id
+func (r *Radio) GetID() string {
+	return r.id
+}
+
 // GetLabel get radio label
 func (r *Radio) GetLabel() string {
 	return r.label
 }
 
-// GetSelected get selected radio
+// GetSelected get checked option
+// moving focus between options does not change it
 func (r *Radio) GetSelected() string {
-	return r.options[r.active].name
+	if len(r.options) == 0 {
+		return ""
+	}
+	return r.options[r.checked].name
+}
+
+// SetValue check option, value must be name of option
+// change handler is called if checked option is changed
+func (r *Radio) SetValue(value interface{}) error {
+	name, ok := value.(string)
+	if !ok {
+		return invalidValue(r.id, value)
+	}
+
+	index := -1
+	for i, opt := range r.options {
+		if opt.name == name {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return invalidValue(r.id, value)
+	}
+
+	old := r.GetSelected()
+	r.checked = index
+	r.drawChecked()
+
+	// highlight moves to the checked option if radio has focus
+	r.unFocusOption()
+	r.active = index
+	if r.hasFocus {
+		r.focusOption()
+	}
+
+	if !r.IsValid() {
+		r.validator.Validate(name)
+	}
+
+	if name != old {
+		return r.emitChange(old, name)
+	}
+
+	return nil
+}
+
+// GetValue get selected option
+func (r *Radio) GetValue() interface{} {
+	return r.GetSelected()
 }
 
 // GetPosition get radio position
@@ -176,50 +247,135 @@ func (r *Radio) GetType() ComponentType {
 	return r.ctype
 }
 
+// OnChange set handler which is called when other option is checked
+func (r *Radio) OnChange(handler func(old, new interface{})) *Radio {
+	r.onChange = handler
+	return r
+}
+
+// OnFocus set handler which is called when radio gets focus
+func (r *Radio) OnFocus(handler func()) *Radio {
+	r.onFocus = handler
+	return r
+}
+
+// OnBlur set handler which is called when radio loses focus
+func (r *Radio) OnBlur(handler func()) *Radio {
+	r.onBlur = handler
+	return r
+}
+
 // Focus focus to radio
 func (r *Radio) Focus() {
-	if len(r.options) != 0 {
-		r.Gui.Cursor = false
-		v, _ := r.Gui.SetCurrentView(r.options[r.active].name)
-		v.Highlight = true
-	}
+	r.focusOption()
+	r.emitFocus()
 }
 
 // UnFocus un focus radio
 func (r *Radio) UnFocus() {
-	if len(r.options) != 0 {
-		v, _ := r.Gui.SetCurrentView(r.options[r.active].name)
-		v.Highlight = false
-	}
+	r.unFocusOption()
+	r.emitBlur()
 }
 
-// Check check radio button
+// Check check focused radio button
 func (r *Radio) Check(g *gocui.Gui, v *gocui.View) error {
-	for _, opt := range r.options {
-		if v, err := r.View(opt.name); err == nil {
+	old := r.GetSelected()
+
+	r.checked = r.active
+	r.drawChecked()
+	r.validator.Validate(r.GetSelected())
+
+	if old != r.GetSelected() {
+		return r.emitChange(old, r.GetSelected())
+	}
+
+	return nil
+}
+
+// drawChecked display check state of options
+func (r *Radio) drawChecked() {
+	for i, opt := range r.options {
+		opt.isChecked = i == r.checked
+		if v, err := r.Gui.View(r.optionName(i)); err == nil {
 			v.Clear()
-			fmt.Fprint(v, opt.unCheck)
+			if opt.isChecked {
+				fmt.Fprint(v, opt.checked)
+			} else {
+				fmt.Fprint(v, opt.unCheck)
+			}
 		}
-		opt.isChecked = false
 	}
+}
 
-	r.options[r.active].isChecked = true
-	v.Clear()
-	fmt.Fprint(v, r.options[r.active].checked)
+// AddValidate add radio validator
+func (r *Radio) AddValidate(errMsg string, validate func(value string) bool) *Radio {
+	r.validator.AddValidate(errMsg, validate)
+	return r
+}
 
-	return nil
+// AddValidates add radio validates
+func (r *Radio) AddValidates(validates ...Validate) *Radio {
+	r.validator.AddValidates(validates...)
+	return r
+}
+
+// Validate validate selected option
+func (r *Radio) Validate() bool {
+	r.validator.Validate(r.GetSelected())
+	return r.validator.IsValid()
+}
+
+// IsValid valid state will be return true
+func (r *Radio) IsValid() bool {
+	return r.validator.IsValid()
+}
+
+// SetDisabled if b is true, radio is dimmed and its handlers are ignored
+func (r *Radio) SetDisabled(b bool) {
+	r.disabled = b
+	r.applyDisabled()
+	r.emitDisabled()
+}
+
+// IsDisabled return disabled state
+func (r *Radio) IsDisabled() bool {
+	return r.disabled
 }
 
-// IsChecked return check state
+// setDimmed dim radio while its fieldset is disabled, its own disabled state is kept
+func (r *Radio) setDimmed(b bool) {
+	r.dimmed = b
+	r.applyDisabled()
+}
+
+// inactive return true if radio is disabled or dimmed
+func (r *Radio) inactive() bool {
+	return r.disabled || r.dimmed
+}
+
+// IsChecked return true if focused option is checked
 func (r *Radio) IsChecked() bool {
-	return r.options[r.active].isChecked
+	return r.active == r.checked
 }
 
 // Draw draw radio
-func (r *Radio) Draw() {
-	if v, err := r.Gui.SetView(r.label, r.X, r.Y, r.W, r.H); err != nil {
+func (r *Radio) Draw() error {
+	if len(r.options) == 0 {
+		return &ViewError{View: r.id, Err: ErrNoOptions}
+	}
+
+	// display error message at the right of options
+	r.validator.X = r.W + 1
+	r.validator.Y = r.Y
+	r.validator.H = r.Y + 2
+	if r.validator.W < r.validator.X {
+		r.validator.W = r.validator.X + 1
+	}
+
+	name := viewName(r.id, "label")
+	if v, err := setView(r.Gui, name, r.X, r.Y, r.W, r.H); err != nil {
 		if err != gocui.ErrUnknownView {
-			panic(err)
+			return newViewError(name, err)
 		}
 
 		v.Frame = false
@@ -229,9 +385,10 @@ func (r *Radio) Draw() {
 	}
 
 	for i, opt := range r.options {
-		if v, err := r.Gui.SetView(opt.name, opt.X, opt.Y, opt.W, opt.H); err != nil {
+		name := r.optionName(i)
+		if v, err := setView(r.Gui, name, opt.X, opt.Y, opt.W, opt.H); err != nil {
 			if err != gocui.ErrUnknownView {
-				panic(err)
+				return newViewError(name, err)
 			}
 
 			v.Frame = false
@@ -240,40 +397,56 @@ func (r *Radio) Draw() {
 			v.SelFgColor = opt.hilightColor
 			v.SelBgColor = opt.hilightBgColor
 
-			fmt.Fprint(v, opt.unCheck)
-
-			if r.handlers != nil {
-				for key, handler := range r.handlers {
-					if err := r.Gui.SetKeybinding(opt.name, key, gocui.ModNone, handler); err != nil {
-						panic(err)
-					}
-				}
+			if err := setKeybindings(r.Gui, name, ignoreDisabled(r.handlers, r.inactive)); err != nil {
+				return err
 			}
-			if i == 0 {
-				r.Focus()
-				r.Check(r.Gui, v)
+			if i == r.active {
+				r.focusOption()
 			}
 		}
 	}
 
+	r.drawChecked()
+
+	r.applyDisabled()
+
+	return nil
 }
 
 // Close close radio
-func (r *Radio) Close() {
-	if err := r.DeleteView(r.label); err != nil {
-		if err != gocui.ErrUnknownView {
-			panic(err)
+func (r *Radio) Close() error {
+	if err := deleteView(r.Gui, viewName(r.id, "label")); err != nil {
+		return err
+	}
+
+	for i := range r.options {
+		name := r.optionName(i)
+		if err := deleteView(r.Gui, name); err != nil {
+			return err
 		}
+		r.DeleteKeybindings(name)
 	}
 
+	return r.validator.CloseValidateMsg()
+}
+
+func (r *Radio) getValidator() *Validator {
+	return r.validator
+}
+
+// setPosition move label and options to x, y
+func (r *Radio) setPosition(x, y int) {
+	dx, dy := x-r.X, y-r.Y
+	r.Position.move(dx, dy)
 	for _, opt := range r.options {
-		if err := r.DeleteView(opt.name); err != nil {
-			if err != gocui.ErrUnknownView {
-				panic(err)
-			}
-		}
-		r.DeleteKeybindings(opt.name)
+		opt.Position.move(dx, dy)
 	}
+	r.validator.Position.move(dx, dy)
+}
+
+// getBounds get area of label and options
+func (r *Radio) getBounds() *Position {
+	return &Position{r.X, r.Y, r.W, r.H}
 }
 
 // AddHandlerOnly add handler only
@@ -281,15 +454,58 @@ func (r *Radio) AddHandlerOnly(key Key, handler Handler) {
 	r.handlers[key] = handler
 }
 
+// applyDisabled change colors of displayed label and options by disabled state
+func (r *Radio) applyDisabled() {
+	if v, err := r.Gui.View(viewName(r.id, "label")); err == nil {
+		v.FgColor = r.textColor
+		if r.inactive() {
+			v.FgColor = disabledTextColor
+		}
+	}
+
+	for i, opt := range r.options {
+		if v, err := r.Gui.View(r.optionName(i)); err == nil {
+			v.FgColor = opt.textColor
+			if r.inactive() {
+				v.FgColor = disabledTextColor
+			}
+		}
+	}
+}
+
+// focusOption focus to active option
+func (r *Radio) focusOption() {
+	if len(r.options) != 0 {
+		r.Gui.Cursor = false
+		if v, err := r.Gui.SetCurrentView(r.optionName(r.active)); err == nil {
+			v.Highlight = true
+		}
+	}
+}
+
+// unFocusOption un focus active option
+func (r *Radio) unFocusOption() {
+	if len(r.options) != 0 {
+		if v, err := r.Gui.View(r.optionName(r.active)); err == nil {
+			v.Highlight = false
+		}
+	}
+}
+
+// optionName get view name of option
+func (r *Radio) optionName(i int) string {
+	return viewName(r.id, fmt.Sprintf("option%d", i))
+}
+
 func (r *Radio) nextRadio(g *gocui.Gui, v *gocui.View) error {
-	r.UnFocus()
+	r.unFocusOption()
 	r.active = (r.active + 1) % len(r.options)
-	r.Focus()
+	r.focusOption()
 	return nil
 }
 
 func (r *Radio) preRadio(g *gocui.Gui, v *gocui.View) error {
-	r.UnFocus()
+	r.unFocusOption()
 
 	if r.active-1 < 0 {
 		r.active = len(r.options) - 1
@@ -297,6 +513,6 @@ func (r *Radio) preRadio(g *gocui.Gui, v *gocui.View) error {
 		r.active = (r.active - 1) % len(r.options)
 	}
 
-	r.Focus()
+	r.focusOption()
 	return nil
 }
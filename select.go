@@ -38,6 +38,13 @@ func NewSelect(gui *gocui.Gui, label string, x, y, labelWidth, fieldWidth int) *
 	return s
 }
 
+// SetID set select id which is used as view name
+// it must be called before Draw
+func (s *Select) SetID(id string) *Select {
+	s.InputField.SetID(id)
+	return s
+}
+
 // AddOptions add select options
 func (s *Select) AddOptions(opts ...string) *Select {
 	for _, opt := range opts {
@@ -70,20 +77,87 @@ func (s *Select) AddListHandler(key Key, handler Handler) *Select {
 	return s
 }
 
+// OnChange set handler which is called when option is selected
+func (s *Select) OnChange(handler func(old, new interface{})) *Select {
+	s.InputField.OnChange(handler)
+	return s
+}
+
+// OnFocus set handler which is called when select gets focus
+func (s *Select) OnFocus(handler func()) *Select {
+	s.InputField.OnFocus(handler)
+	return s
+}
+
+// OnBlur set handler which is called when select loses focus
+func (s *Select) OnBlur(handler func()) *Select {
+	s.InputField.OnBlur(handler)
+	return s
+}
+
+// SetValue select option, value must be one of options
+// change handler is called if selected option is changed
+func (s *Select) SetValue(value interface{}) error {
+	opt, ok := value.(string)
+	if !ok {
+		return invalidValue(s.id, value)
+	}
+	if opt == s.GetSelected() {
+		return nil
+	}
+
+	index := -1
+	for i, o := range s.options {
+		if o == opt {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return invalidValue(s.id, value)
+	}
+
+	old := s.GetSelected()
+	s.currentOpt = index
+	s.InputField.SetText(opt)
+
+	if !s.IsValid() {
+		s.field.Validate(opt)
+	}
+
+	return s.emitChange(old, opt)
+}
+
+// GetValue get selected option
+func (s *Select) GetValue() interface{} {
+	return s.GetSelected()
+}
+
 // GetSelected get selected option
 func (s *Select) GetSelected() string {
+	if !s.hasOpts() {
+		return ""
+	}
 	return s.options[s.currentOpt]
 }
 
+// Validate validate selected option
+func (s *Select) Validate() bool {
+	s.field.Validate(s.GetSelected())
+	return s.IsValid()
+}
+
 // Focus set focus to select
 func (s *Select) Focus() {
 	s.Gui.Cursor = true
-	s.Gui.SetCurrentView(s.GetLabel())
+	s.Gui.SetCurrentView(s.id)
+	s.emitFocus()
 }
 
 // UnFocus un focus
 func (s *Select) UnFocus() {
 	s.Gui.Cursor = false
+	s.emitBlur()
 }
 
 // GetType get component type
@@ -92,22 +166,26 @@ func (s *Select) GetType() ComponentType {
 }
 
 // Close close select
-func (s *Select) Close() {
-	s.InputField.Close()
+func (s *Select) Close() error {
 	if s.isExpanded {
-		for _, opt := range s.options {
-			s.DeleteView(opt)
-			s.DeleteKeybindings(opt)
+		for i := range s.options {
+			name := s.optionName(i)
+			if err := deleteView(s.Gui, name); err != nil {
+				return err
+			}
+			s.DeleteKeybindings(name)
 		}
 	}
+
+	return s.InputField.Close()
 }
 
 // Draw draw select
-func (s *Select) Draw() {
+func (s *Select) Draw() error {
 	if len(s.options) > 0 {
-		s.InputField.SetText(s.options[0])
+		s.InputField.SetText(s.GetSelected())
 	}
-	s.InputField.Draw()
+	return s.InputField.Draw()
 }
 
 func (s *Select) nextOpt(g *gocui.Gui, v *gocui.View) error {
@@ -124,7 +202,10 @@ func (s *Select) nextOpt(g *gocui.Gui, v *gocui.View) error {
 	}
 
 	s.currentOpt = next
-	v, _ = g.SetCurrentView(s.options[next])
+	v, err := g.SetCurrentView(s.optionName(next))
+	if err != nil {
+		return newViewError(s.optionName(next), err)
+	}
 
 	v.Highlight = true
 
@@ -145,7 +226,10 @@ func (s *Select) preOpt(g *gocui.Gui, v *gocui.View) error {
 	}
 
 	s.currentOpt = next
-	v, _ = g.SetCurrentView(s.options[next])
+	v, err := g.SetCurrentView(s.optionName(next))
+	if err != nil {
+		return newViewError(s.optionName(next), err)
+	}
 
 	v.Highlight = true
 
@@ -154,12 +238,10 @@ func (s *Select) preOpt(g *gocui.Gui, v *gocui.View) error {
 
 func (s *Select) selectOpt(g *gocui.Gui, v *gocui.View) error {
 	if !s.isExpanded {
-		s.expandOpt(g, v)
-	} else {
-		s.closeOpt(g, v)
+		return s.expandOpt(g, v)
 	}
 
-	return nil
+	return s.closeOpt(g, v)
 }
 
 func (s *Select) expandOpt(g *gocui.Gui, vi *gocui.View) error {
@@ -173,12 +255,13 @@ func (s *Select) expandOpt(g *gocui.Gui, vi *gocui.View) error {
 		y := s.field.Y
 		h := y + 2
 
-		for _, opt := range s.options {
+		for i, opt := range s.options {
 			y++
 			h++
-			if v, err := g.SetView(opt, x, y, w, h); err != nil {
+			name := s.optionName(i)
+			if v, err := setView(g, name, x, y, w, h); err != nil {
 				if err != gocui.ErrUnknownView {
-					panic(err)
+					return newViewError(name, err)
 				}
 
 				v.Frame = false
@@ -187,10 +270,8 @@ func (s *Select) expandOpt(g *gocui.Gui, vi *gocui.View) error {
 				v.FgColor = s.listColor.hilightColor
 				v.BgColor = s.listColor.hilightBgColor
 
-				for key, handler := range s.listHandlers {
-					if err := g.SetKeybinding(v.Name(), key, gocui.ModNone, handler); err != nil {
-						panic(err)
-					}
+				if err := setKeybindings(g, v.Name(), ignoreDisabled(s.listHandlers, s.inactive)); err != nil {
+					return err
 				}
 
 				fmt.Fprint(v, opt)
@@ -198,7 +279,10 @@ func (s *Select) expandOpt(g *gocui.Gui, vi *gocui.View) error {
 
 		}
 
-		v, _ := g.SetCurrentView(s.options[s.currentOpt])
+		v, err := g.SetCurrentView(s.optionName(s.currentOpt))
+		if err != nil {
+			return newViewError(s.optionName(s.currentOpt), err)
+		}
 		v.Highlight = true
 	}
 
@@ -209,20 +293,40 @@ func (s *Select) closeOpt(g *gocui.Gui, v *gocui.View) error {
 	s.isExpanded = false
 	g.Cursor = true
 
-	for _, opt := range s.options {
-		g.DeleteView(opt)
-		g.DeleteKeybindings(opt)
+	for i := range s.options {
+		name := s.optionName(i)
+		if err := deleteView(g, name); err != nil {
+			return err
+		}
+		g.DeleteKeybindings(name)
+	}
+
+	v, err := g.SetCurrentView(s.id)
+	if err != nil {
+		return newViewError(s.id, err)
 	}
 
-	v, _ = g.SetCurrentView(s.GetLabel())
+	old := s.field.text
+	s.field.text = s.GetSelected()
 
 	v.Clear()
 
 	fmt.Fprint(v, s.GetSelected())
 
+	s.field.Validate(s.GetSelected())
+
+	if s.field.text != old {
+		return s.emitChange(old, s.field.text)
+	}
+
 	return nil
 }
 
+// optionName get view name of option
+func (s *Select) optionName(i int) string {
+	return viewName(s.id, fmt.Sprintf("option%d", i))
+}
+
 func (s *Select) hasOpts() bool {
 	if len(s.options) > 0 {
 		return true
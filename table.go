@@ -0,0 +1,979 @@
+package component
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jroimartin/gocui"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+// Align column text align, it is also used for component in form grid
+type Align int
+
+const (
+	// AlignLeft align text to left
+	AlignLeft Align = iota
+	// AlignRight align text to right
+	AlignRight
+	// AlignCenter align text to center
+	AlignCenter
+)
+
+// SortOrder sort order
+type SortOrder int
+
+const (
+	// SortAsc sort ascending
+	SortAsc SortOrder = iota
+	// SortDesc sort descending
+	SortDesc
+)
+
+const (
+	sortAscMark  = "\u25b2"
+	sortDescMark = "\u25bc"
+)
+
+// Column table column
+type Column struct {
+	title      string
+	width      int
+	align      Align
+	comparator Comparator
+}
+
+// Table struct
+type Table struct {
+	*gocui.Gui
+	id          string
+	label       string
+	columns     []*Column
+	source      TableDataSource
+	offset      int
+	currentRow  int
+	selectedRow int
+	selected    []string
+	column      int
+	sortColumn  int
+	sortOrder   SortOrder
+	query       string
+	filter      *InputField
+	handlers    Handlers
+	headHandler Handlers
+	onSelect    func(row int, values []string) error
+	disabled    bool
+	dimmed      bool
+	events
+	ctype       ComponentType
+	headerColor *Attributes
+	validator   *Validator
+	*Position
+	*Attributes
+}
+
+// NewTable new table
+func NewTable(gui *gocui.Gui, label string, x, y, w, h int) *Table {
+	// label, header and at least one row
+	if h < 3 {
+		h = 3
+	}
+
+	t := &Table{
+		Gui:         gui,
+		id:          label,
+		label:       label,
+		source:      NewSliceDataSource(nil),
+		selectedRow: -1,
+		sortColumn:  -1,
+		handlers:    make(Handlers),
+		headHandler: make(Handlers),
+		validator:   NewValidator(gui, viewName(label, "validator"), x+len(label)+2, y, x+len(label)+2, y+2),
+		ctype:       TypeTable,
+		Position: &Position{
+			X: x,
+			Y: y,
+			W: x + w,
+			H: y + h,
+		},
+		Attributes: &Attributes{
+			textColor:      gocui.ColorWhite,
+			textBgColor:    gocui.ColorDefault,
+			hilightColor:   gocui.ColorBlack,
+			hilightBgColor: gocui.ColorCyan,
+		},
+		headerColor: &Attributes{
+			textColor:      gocui.ColorYellow | gocui.AttrBold,
+			textBgColor:    gocui.ColorDefault,
+			hilightColor:   gocui.ColorBlack | gocui.AttrBold,
+			hilightBgColor: gocui.ColorYellow,
+		},
+	}
+
+	t.AddHandler(gocui.KeyArrowDown, t.nextRow).
+		AddHandler('j', t.nextRow).
+		AddHandler(gocui.KeyArrowUp, t.preRow).
+		AddHandler('k', t.preRow).
+		AddHandler(gocui.KeyPgdn, t.nextPage).
+		AddHandler(gocui.KeyPgup, t.prePage).
+		AddHandler(gocui.KeyEnter, t.Select).
+		AddHandler('/', t.focusFilter)
+
+	t.headHandler[gocui.KeyArrowRight] = t.nextColumn
+	t.headHandler['l'] = t.nextColumn
+	t.headHandler[gocui.KeyArrowLeft] = t.preColumn
+	t.headHandler['h'] = t.preColumn
+	t.headHandler[gocui.KeyEnter] = t.toggleSort
+	t.headHandler[gocui.KeySpace] = t.toggleSort
+	t.headHandler[gocui.KeyArrowDown] = t.focusRows
+	t.headHandler['j'] = t.focusRows
+	t.headHandler['/'] = t.focusFilter
+
+	return t
+}
+
+// AddColumn add column
+func (t *Table) AddColumn(title string, width int, align Align) *Table {
+	// keep space for sort mark
+	if runewidth.StringWidth(title)+2 > width {
+		width = runewidth.StringWidth(title) + 2
+	}
+
+	t.columns = append(t.columns, &Column{
+		title:      title,
+		width:      width,
+		align:      align,
+		comparator: CompareString,
+	})
+
+	if w := t.X + t.rowWidth() + 1; w > t.W {
+		t.W = w
+	}
+
+	return t
+}
+
+// SetComparator set comparator used to sort column
+func (t *Table) SetComparator(column int, comparator Comparator) *Table {
+	if column >= 0 && column < len(t.columns) {
+		t.columns[column].comparator = comparator
+	}
+	return t
+}
+
+// AddRow add row
+// it works only with SliceDataSource
+func (t *Table) AddRow(values ...string) *Table {
+	if s, ok := t.source.(*SliceDataSource); ok {
+		s.AddRow(values...)
+		t.Refresh()
+	}
+	return t
+}
+
+// SetRows replace all rows
+// it works only with SliceDataSource
+func (t *Table) SetRows(rows [][]string) *Table {
+	if s, ok := t.source.(*SliceDataSource); ok {
+		s.SetRows(rows)
+		t.resetRows()
+	}
+	return t
+}
+
+// SetDataSource set data source
+func (t *Table) SetDataSource(source TableDataSource) *Table {
+	t.source = source
+	t.resetRows()
+	return t
+}
+
+// GetDataSource get data source
+func (t *Table) GetDataSource() TableDataSource {
+	return t.source
+}
+
+// SetFilterable display filter field above the header
+// it works only with FilterableDataSource
+func (t *Table) SetFilterable(labelWidth, fieldWidth int) *Table {
+	t.filter = NewInputField(t.Gui, t.label+" filter", t.X, t.Y+1, labelWidth, fieldWidth).
+		SetID(viewName(t.id, "filter")).
+		AddHandler(gocui.KeyEnter, t.focusRows).
+		AddHandler(gocui.KeyArrowDown, t.focusRows)
+
+	t.H++
+
+	return t
+}
+
+// SortBy sort rows by column
+// it works only with SortableDataSource, and current row moves to the selected row
+func (t *Table) SortBy(column int, order SortOrder) *Table {
+	if column < 0 || column >= len(t.columns) {
+		return t
+	}
+
+	s, ok := t.source.(SortableDataSource)
+	if !ok {
+		return t
+	}
+
+	t.column = column
+	t.sortColumn = column
+	t.sortOrder = order
+	s.Sort(column, order, t.columns[column].comparator)
+	t.locateSelected()
+	t.Refresh()
+	t.drawHeader()
+
+	return t
+}
+
+// Filter show only rows which contain query
+// current row moves to the selected row if it is shown, otherwise to the first row
+func (t *Table) Filter(query string) *Table {
+	s, ok := t.source.(FilterableDataSource)
+	if !ok {
+		return t
+	}
+
+	t.query = query
+	s.Filter(query)
+	t.locateSelected()
+	t.Refresh()
+
+	return t
+}
+
+// Refresh fetch displayed rows from data source again
+func (t *Table) Refresh() *Table {
+	count := t.source.RowCount()
+	if t.currentRow >= count {
+		t.currentRow = count - 1
+	}
+	if t.currentRow < 0 {
+		t.currentRow = 0
+	}
+
+	if v, err := t.Gui.View(t.id); err == nil {
+		t.render(v)
+	}
+
+	return t
+}
+
+// AddHandler add handler
+func (t *Table) AddHandler(key Key, handler Handler) *Table {
+	t.handlers[key] = handler
+	return t
+}
+
+// SetSelectHandler set handler called when row is selected
+func (t *Table) SetSelectHandler(handler func(row int, values []string) error) *Table {
+	t.onSelect = handler
+	return t
+}
+
+// SetTextColor set row text color
+func (t *Table) SetTextColor(fgColor, bgColor gocui.Attribute) *Table {
+	t.textColor = fgColor
+	t.textBgColor = bgColor
+	return t
+}
+
+// SetHilightColor set current row color
+func (t *Table) SetHilightColor(fgColor, bgColor gocui.Attribute) *Table {
+	t.hilightColor = fgColor
+	t.hilightBgColor = bgColor
+	return t
+}
+
+// SetHeaderColor set header color
+func (t *Table) SetHeaderColor(fgColor, bgColor gocui.Attribute) *Table {
+	t.headerColor.textColor = fgColor
+	t.headerColor.textBgColor = bgColor
+	return t
+}
+
+// SetID set table id which is used as view name
+// it must be called before Draw
+func (t *Table) SetID(id string) *Table {
+	t.id = id
+	t.validator.name = viewName(id, "validator")
+	if t.filter != nil {
+		t.filter.SetID(viewName(id, "filter"))
+	}
+	return t
+}
+
+// GetID get table id
+func (t *Table) GetID() string {
+	return t.id
+}
+
+// GetLabel get table label
+func (t *Table) GetLabel() string {
+	return t.label
+}
+
+// GetPosition get table position
+func (t *Table) GetPosition() *Position {
+	return t.Position
+}
+
+// GetType get component type
+func (t *Table) GetType() ComponentType {
+	return t.ctype
+}
+
+// GetRowCount get row count
+func (t *Table) GetRowCount() int {
+	return t.source.RowCount()
+}
+
+// GetCurrentRow get current row index
+func (t *Table) GetCurrentRow() int {
+	return t.currentRow
+}
+
+// GetSortColumn get sorted column and order
+// if table is not sorted column is -1
+func (t *Table) GetSortColumn() (int, SortOrder) {
+	return t.sortColumn, t.sortOrder
+}
+
+// GetSelectedRow get selected row values
+// if no row is selected return nil
+func (t *Table) GetSelectedRow() []string {
+	return t.selected
+}
+
+// SetValue select row which has the values, value must be []string
+// nil clears selection, and change handler is called if selection is changed
+func (t *Table) SetValue(value interface{}) error {
+	values, ok := value.([]string)
+	if !ok && value != nil {
+		return invalidValue(t.id, value)
+	}
+	if reflect.DeepEqual(values, t.selected) {
+		return nil
+	}
+
+	old := t.selected
+	if values == nil {
+		t.selectedRow = -1
+		t.selected = nil
+	} else {
+		index := t.rowIndex(values)
+		if index < 0 {
+			return invalidValue(t.id, value)
+		}
+
+		t.selectedRow = index
+		t.selected = values
+		t.currentRow = index
+		if v, err := t.Gui.View(t.id); err == nil {
+			if err := t.moveRow(v, 0); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !t.IsValid() {
+		t.validator.Validate("")
+	}
+
+	return t.emitChange(old, t.selected)
+}
+
+// GetValue get selected row values
+func (t *Table) GetValue() interface{} {
+	return t.selected
+}
+
+// Select select current row
+func (t *Table) Select(g *gocui.Gui, v *gocui.View) error {
+	rows := t.source.Rows(t.currentRow, t.currentRow+1)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	old := t.selected
+	t.selectedRow = t.currentRow
+	t.selected = rows[0]
+
+	t.validator.Validate("")
+
+	if err := t.emitChange(old, t.selected); err != nil {
+		return err
+	}
+
+	if t.onSelect != nil {
+		return t.onSelect(t.selectedRow, t.selected)
+	}
+
+	return nil
+}
+
+// AddValidate add table validator
+func (t *Table) AddValidate(errMsg string, validate func(row []string) bool) *Table {
+	t.validator.AddValidate(errMsg, func(string) bool {
+		return validate(t.selected)
+	})
+	return t
+}
+
+// Validate validate selected row
+func (t *Table) Validate() bool {
+	t.validator.Validate("")
+	return t.validator.IsValid()
+}
+
+// IsValid valid state will be return true
+func (t *Table) IsValid() bool {
+	return t.validator.IsValid()
+}
+
+// SetDisabled if b is true, table is dimmed and its handlers are ignored
+func (t *Table) SetDisabled(b bool) {
+	t.disabled = b
+	if t.filter != nil {
+		t.filter.SetDisabled(b)
+	}
+	t.applyDisabled()
+	t.emitDisabled()
+}
+
+// IsDisabled return disabled state
+func (t *Table) IsDisabled() bool {
+	return t.disabled
+}
+
+// setDimmed dim table while its fieldset is disabled, its own disabled state is kept
+func (t *Table) setDimmed(b bool) {
+	t.dimmed = b
+	if t.filter != nil {
+		t.filter.setDimmed(b)
+	}
+	t.applyDisabled()
+}
+
+// inactive return true if table is disabled or dimmed
+func (t *Table) inactive() bool {
+	return t.disabled || t.dimmed
+}
+
+// OnChange set handler which is called when row is selected
+// old and new are values of the rows
+func (t *Table) OnChange(handler func(old, new interface{})) *Table {
+	t.onChange = handler
+	return t
+}
+
+// OnFocus set handler which is called when table gets focus
+func (t *Table) OnFocus(handler func()) *Table {
+	t.onFocus = handler
+	return t
+}
+
+// OnBlur set handler which is called when table loses focus
+func (t *Table) OnBlur(handler func()) *Table {
+	t.onBlur = handler
+	return t
+}
+
+// Focus focus to table
+func (t *Table) Focus() {
+	t.Gui.Cursor = false
+	if v, err := t.Gui.SetCurrentView(t.id); err == nil {
+		v.Highlight = true
+	}
+	t.emitFocus()
+}
+
+// UnFocus un focus
+func (t *Table) UnFocus() {
+	if v, err := t.Gui.View(t.id); err == nil {
+		v.Highlight = false
+	}
+	if v, err := t.Gui.View(t.columnName(t.column)); err == nil {
+		v.Highlight = false
+	}
+	t.emitBlur()
+}
+
+// Draw draw table
+func (t *Table) Draw() error {
+	// draw label
+	name := viewName(t.id, "label")
+	if v, err := setView(t.Gui, name, t.X, t.Y, t.W, t.Y+2); err != nil {
+		if err != gocui.ErrUnknownView {
+			return newViewError(name, err)
+		}
+
+		v.Frame = false
+		v.FgColor = gocui.ColorYellow | gocui.AttrBold
+		v.BgColor = gocui.ColorDefault
+		fmt.Fprint(v, t.label)
+	}
+
+	// draw filter
+	if t.filter != nil {
+		if err := t.filter.Draw(); err != nil {
+			return err
+		}
+		if v, err := t.Gui.View(t.filter.GetID()); err == nil {
+			v.Editor = gocui.EditorFunc(t.editFilter)
+		}
+	}
+
+	// draw header
+	y := t.headerY()
+	x := t.X
+	for i, c := range t.columns {
+		name := t.columnName(i)
+		if v, err := setView(t.Gui, name, x, y, x+c.width+1, y+2); err != nil {
+			if err != gocui.ErrUnknownView {
+				return newViewError(name, err)
+			}
+
+			v.Frame = false
+			v.FgColor = t.headerColor.textColor
+			v.BgColor = t.headerColor.textBgColor
+			v.SelFgColor = t.headerColor.hilightColor
+			v.SelBgColor = t.headerColor.hilightBgColor
+
+			if err := setKeybindings(t.Gui, name, ignoreDisabled(t.headHandler, t.inactive)); err != nil {
+				return err
+			}
+		}
+		x += c.width + 1
+	}
+	t.drawHeader()
+
+	// draw rows
+	if v, err := setView(t.Gui, t.id, t.X, y+1, t.W, t.H); err != nil {
+		if err != gocui.ErrUnknownView {
+			return newViewError(t.id, err)
+		}
+
+		v.Frame = false
+		v.FgColor = t.textColor
+		v.BgColor = t.textBgColor
+		v.SelFgColor = t.hilightColor
+		v.SelBgColor = t.hilightBgColor
+
+		t.render(v)
+
+		if err := setKeybindings(t.Gui, t.id, ignoreDisabled(t.handlers, t.inactive)); err != nil {
+			return err
+		}
+	}
+
+	t.applyDisabled()
+
+	return nil
+}
+
+// Close close table
+func (t *Table) Close() error {
+	views := []string{
+		viewName(t.id, "label"),
+		t.id,
+	}
+
+	for i := range t.columns {
+		views = append(views, t.columnName(i))
+	}
+
+	for _, v := range views {
+		if err := deleteView(t.Gui, v); err != nil {
+			return err
+		}
+		t.DeleteKeybindings(v)
+	}
+
+	if t.filter != nil {
+		if err := t.filter.Close(); err != nil {
+			return err
+		}
+	}
+
+	return t.validator.CloseValidateMsg()
+}
+
+// AddHandlerOnly add handler if the key is not used by table
+func (t *Table) AddHandlerOnly(key Key, handler Handler) {
+	if _, ok := t.handlers[key]; ok {
+		return
+	}
+	t.AddHandler(key, handler)
+
+	if _, ok := t.headHandler[key]; !ok {
+		t.headHandler[key] = handler
+	}
+
+	if t.filter != nil {
+		t.filter.AddHandlerOnly(key, handler)
+	}
+}
+
+func (t *Table) getValidator() *Validator {
+	return t.validator
+}
+
+// applyDisabled change colors of displayed label and rows by disabled state
+func (t *Table) applyDisabled() {
+	if v, err := t.Gui.View(viewName(t.id, "label")); err == nil {
+		v.FgColor = gocui.ColorYellow | gocui.AttrBold
+		if t.inactive() {
+			v.FgColor = disabledTextColor
+		}
+	}
+
+	if v, err := t.Gui.View(t.id); err == nil {
+		v.FgColor = t.textColor
+		if t.inactive() {
+			v.FgColor = disabledTextColor
+		}
+	}
+}
+
+// setPosition move label, filter and rows to x, y
+func (t *Table) setPosition(x, y int) {
+	dx, dy := x-t.X, y-t.Y
+	t.Position.move(dx, dy)
+	t.validator.Position.move(dx, dy)
+	if t.filter != nil {
+		t.filter.setPosition(t.X, t.Y+1)
+	}
+}
+
+// getBounds get area of table
+func (t *Table) getBounds() *Position {
+	return &Position{t.X, t.Y, t.W, t.H}
+}
+
+func (t *Table) nextRow(g *gocui.Gui, v *gocui.View) error {
+	return t.moveRow(v, 1)
+}
+
+func (t *Table) preRow(g *gocui.Gui, v *gocui.View) error {
+	// move to header from first row
+	if t.currentRow == 0 && len(t.columns) != 0 {
+		v.Highlight = false
+		return t.focusColumn(t.column)
+	}
+	return t.moveRow(v, -1)
+}
+
+func (t *Table) nextColumn(g *gocui.Gui, v *gocui.View) error {
+	return t.focusColumn((t.column + 1) % len(t.columns))
+}
+
+func (t *Table) preColumn(g *gocui.Gui, v *gocui.View) error {
+	if t.column-1 < 0 {
+		return t.focusColumn(len(t.columns) - 1)
+	}
+	return t.focusColumn(t.column - 1)
+}
+
+func (t *Table) focusColumn(column int) error {
+	if v, err := t.Gui.View(t.columnName(t.column)); err == nil {
+		v.Highlight = false
+	}
+
+	t.column = column
+
+	v, err := t.Gui.SetCurrentView(t.columnName(column))
+	if err != nil {
+		return err
+	}
+	v.Highlight = true
+
+	return nil
+}
+
+// toggleSort sort by focused column, toggle order if it is already sorted
+func (t *Table) toggleSort(g *gocui.Gui, v *gocui.View) error {
+	order := SortAsc
+	if t.sortColumn == t.column && t.sortOrder == SortAsc {
+		order = SortDesc
+	}
+
+	t.SortBy(t.column, order)
+	return nil
+}
+
+func (t *Table) focusRows(g *gocui.Gui, v *gocui.View) error {
+	if cv, err := t.Gui.View(t.columnName(t.column)); err == nil {
+		cv.Highlight = false
+	}
+
+	t.Focus()
+	return nil
+}
+
+func (t *Table) focusFilter(g *gocui.Gui, v *gocui.View) error {
+	if t.filter == nil {
+		return nil
+	}
+
+	v.Highlight = false
+	t.filter.Focus()
+	return nil
+}
+
+func (t *Table) editFilter(v *gocui.View, key gocui.Key, ch rune, mod gocui.Modifier) {
+	t.filter.Edit(v, key, ch, mod)
+	if t.query != t.filter.GetFieldText() {
+		t.Filter(t.filter.GetFieldText())
+	}
+}
+
+func (t *Table) nextPage(g *gocui.Gui, v *gocui.View) error {
+	return t.moveRow(v, t.pageSize())
+}
+
+func (t *Table) prePage(g *gocui.Gui, v *gocui.View) error {
+	return t.moveRow(v, -t.pageSize())
+}
+
+func (t *Table) moveRow(v *gocui.View, delta int) error {
+	if !t.scroll(delta) {
+		return v.SetCursor(0, t.currentRow-t.offset)
+	}
+
+	t.render(v)
+	return nil
+}
+
+// scroll move current row by delta
+// it returns true if current row is out of page and rows must be fetched again
+func (t *Table) scroll(delta int) bool {
+	count := t.source.RowCount()
+	if count == 0 {
+		return false
+	}
+
+	next := t.currentRow + delta
+	if next >= count {
+		next = count - 1
+	}
+	if next < 0 {
+		next = 0
+	}
+
+	t.currentRow = next
+
+	return t.currentRow < t.offset || t.currentRow >= t.offset+t.pageSize()
+}
+
+// rowIndex find row which has the values, return -1 if it is not found
+// rows are fetched page by page until the row is found
+func (t *Table) rowIndex(values []string) int {
+	size := t.pageSize()
+	count := t.source.RowCount()
+
+	for start := 0; start < count; start += size {
+		for i, row := range t.source.Rows(start, start+size) {
+			if reflect.DeepEqual(row, values) {
+				return start + i
+			}
+		}
+	}
+
+	return -1
+}
+
+// locateSelected find selected row again after rows are sorted or filtered
+// current row moves to the selected row, or to the first row if it is not displayed
+func (t *Table) locateSelected() {
+	t.currentRow = 0
+	t.offset = 0
+	t.selectedRow = -1
+
+	if t.selected == nil {
+		return
+	}
+
+	if index := t.rowIndex(t.selected); index >= 0 {
+		t.selectedRow = index
+		t.currentRow = index
+		if size := t.pageSize(); index >= size {
+			t.offset = index - size + 1
+		}
+	}
+}
+
+func (t *Table) resetRows() {
+	t.currentRow = 0
+	t.offset = 0
+	t.selectedRow = -1
+	t.selected = nil
+	t.Refresh()
+}
+
+// render draw only rows in current page
+func (t *Table) render(v *gocui.View) {
+	v.Clear()
+	t.writePage(v)
+	v.SetOrigin(0, 0)
+	v.SetCursor(0, t.currentRow-t.offset)
+}
+
+// writePage move page to current row and write its rows
+// only rows in the page are fetched from data source
+func (t *Table) writePage(w io.Writer) {
+	size := t.pageSize()
+
+	if t.currentRow < t.offset {
+		t.offset = t.currentRow
+	}
+	if t.currentRow >= t.offset+size {
+		t.offset = t.currentRow - size + 1
+	}
+
+	for _, row := range t.source.Rows(t.offset, t.offset+size) {
+		fmt.Fprintln(w, t.formatRow(row))
+	}
+}
+
+// drawHeader draw column titles with sort mark
+func (t *Table) drawHeader() {
+	for i, c := range t.columns {
+		v, err := t.Gui.View(t.columnName(i))
+		if err != nil {
+			continue
+		}
+
+		title := c.title
+		if i == t.sortColumn {
+			if t.sortOrder == SortAsc {
+				title += " " + sortAscMark
+			} else {
+				title += " " + sortDescMark
+			}
+		}
+
+		v.Clear()
+		fmt.Fprint(v, alignText(title, c.width, c.align))
+	}
+}
+
+func (t *Table) formatRow(values []string) string {
+	cells := make([]string, len(t.columns))
+
+	for i, c := range t.columns {
+		cells[i] = alignText(cell(values, i), c.width, c.align)
+	}
+
+	return strings.Join(cells, " ")
+}
+
+func (t *Table) rowWidth() int {
+	w := 0
+	for _, c := range t.columns {
+		w += c.width + 1
+	}
+
+	return w
+}
+
+func (t *Table) pageSize() int {
+	size := t.H - t.headerY() - 2
+	if size < 1 {
+		size = 1
+	}
+
+	return size
+}
+
+func (t *Table) headerY() int {
+	if t.filter != nil {
+		return t.Y + 2
+	}
+	return t.Y + 1
+}
+
+func (t *Table) columnName(i int) string {
+	return viewName(t.id, fmt.Sprintf("header%d", i))
+}
+
+func alignText(text string, width int, align Align) string {
+	// wide characters take two cells
+	if runewidth.StringWidth(text) > width {
+		text = runewidth.Truncate(text, width, "")
+	}
+
+	space := width - runewidth.StringWidth(text)
+
+	switch align {
+	case AlignRight:
+		return strings.Repeat(" ", space) + text
+	case AlignCenter:
+		left := space / 2
+		return strings.Repeat(" ", left) + text + strings.Repeat(" ", space-left)
+	default:
+		return text + strings.Repeat(" ", space)
+	}
+}
+
+// Comparator compare two cell values
+// return negative if a < b, zero if a == b, positive if a > b
+type Comparator func(a, b string) int
+
+// CompareString compare as string
+func CompareString(a, b string) int {
+	return strings.Compare(a, b)
+}
+
+// CompareNumeric compare as number
+// values which are not number are sorted after numbers
+func CompareNumeric(a, b string) int {
+	x, errA := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	y, errB := strconv.ParseFloat(strings.TrimSpace(b), 64)
+
+	switch {
+	case errA != nil && errB != nil:
+		return strings.Compare(a, b)
+	case errA != nil:
+		return 1
+	case errB != nil:
+		return -1
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+
+	return 0
+}
+
+// CompareTime return comparator which compare as time with layout
+// values which cannot be parsed are sorted after times
+func CompareTime(layout string) Comparator {
+	return func(a, b string) int {
+		x, errA := time.Parse(layout, strings.TrimSpace(a))
+		y, errB := time.Parse(layout, strings.TrimSpace(b))
+
+		switch {
+		case errA != nil && errB != nil:
+			return strings.Compare(a, b)
+		case errA != nil:
+			return 1
+		case errB != nil:
+			return -1
+		case x.Before(y):
+			return -1
+		case x.After(y):
+			return 1
+		}
+
+		return 0
+	}
+}
@@ -0,0 +1,218 @@
+package component
+
+import (
+	"sort"
+	"strings"
+)
+
+// TableDataSource provide rows to table
+// table fetch only rows which are displayed
+type TableDataSource interface {
+	// RowCount return number of rows
+	RowCount() int
+	// Rows return rows in range [start, end)
+	Rows(start, end int) [][]string
+}
+
+// SortableDataSource data source which can sort rows
+type SortableDataSource interface {
+	TableDataSource
+	Sort(column int, order SortOrder, comparator Comparator)
+}
+
+// FilterableDataSource data source which can filter rows
+type FilterableDataSource interface {
+	TableDataSource
+	Filter(query string)
+}
+
+// PagedDataSource data source which fetches rows page by page when they are displayed
+// fetched pages are cached, so scrolling back does not fetch them again
+type PagedDataSource struct {
+	count    int
+	pageSize int
+	fetch    func(start, end int) [][]string
+	pages    map[int][][]string
+}
+
+// NewPagedDataSource new paged data source
+// fetch is called with range [start, end) of a page when rows in the page are displayed first
+func NewPagedDataSource(count, pageSize int, fetch func(start, end int) [][]string) *PagedDataSource {
+	if pageSize < 1 {
+		pageSize = 1
+	}
+
+	return &PagedDataSource{
+		count:    count,
+		pageSize: pageSize,
+		fetch:    fetch,
+		pages:    make(map[int][][]string),
+	}
+}
+
+// SetRowCount set number of rows and drop fetched pages
+func (p *PagedDataSource) SetRowCount(count int) {
+	p.count = count
+	p.pages = make(map[int][][]string)
+}
+
+// RowCount return number of rows
+func (p *PagedDataSource) RowCount() int {
+	return p.count
+}
+
+// Rows return rows in range [start, end), pages which are not fetched yet are fetched
+func (p *PagedDataSource) Rows(start, end int) [][]string {
+	if start < 0 {
+		start = 0
+	}
+	if end > p.count {
+		end = p.count
+	}
+	if start >= end {
+		return nil
+	}
+
+	rows := make([][]string, 0, end-start)
+	for i := start; i < end; i++ {
+		page := p.page(i / p.pageSize)
+		if j := i % p.pageSize; j < len(page) {
+			rows = append(rows, page[j])
+		}
+	}
+
+	return rows
+}
+
+// page get fetched page or fetch it
+func (p *PagedDataSource) page(n int) [][]string {
+	if page, ok := p.pages[n]; ok {
+		return page
+	}
+
+	start := n * p.pageSize
+	end := start + p.pageSize
+	if end > p.count {
+		end = p.count
+	}
+
+	page := p.fetch(start, end)
+	p.pages[n] = page
+
+	return page
+}
+
+// SliceDataSource data source which has rows in memory
+type SliceDataSource struct {
+	rows       [][]string
+	index      []int
+	query      string
+	sortColumn int
+	sortOrder  SortOrder
+	comparator Comparator
+}
+
+// NewSliceDataSource new slice data source
+func NewSliceDataSource(rows [][]string) *SliceDataSource {
+	s := &SliceDataSource{
+		rows:       rows,
+		sortColumn: -1,
+	}
+
+	s.update()
+
+	return s
+}
+
+// AddRow add row
+func (s *SliceDataSource) AddRow(values ...string) {
+	s.rows = append(s.rows, values)
+	s.update()
+}
+
+// SetRows replace all rows
+func (s *SliceDataSource) SetRows(rows [][]string) {
+	s.rows = rows
+	s.update()
+}
+
+// RowCount return number of rows after filtering
+func (s *SliceDataSource) RowCount() int {
+	return len(s.index)
+}
+
+// Rows return rows in range [start, end)
+func (s *SliceDataSource) Rows(start, end int) [][]string {
+	if start < 0 {
+		start = 0
+	}
+	if end > len(s.index) {
+		end = len(s.index)
+	}
+	if start >= end {
+		return nil
+	}
+
+	rows := make([][]string, 0, end-start)
+	for _, i := range s.index[start:end] {
+		rows = append(rows, s.rows[i])
+	}
+
+	return rows
+}
+
+// Sort sort rows by column
+func (s *SliceDataSource) Sort(column int, order SortOrder, comparator Comparator) {
+	s.sortColumn = column
+	s.sortOrder = order
+	s.comparator = comparator
+	s.update()
+}
+
+// Filter show only rows which contain query
+func (s *SliceDataSource) Filter(query string) {
+	s.query = query
+	s.update()
+}
+
+// update filter and sort rows
+func (s *SliceDataSource) update() {
+	query := strings.ToLower(s.query)
+
+	s.index = s.index[:0]
+	for i, row := range s.rows {
+		if query == "" || matchRow(row, query) {
+			s.index = append(s.index, i)
+		}
+	}
+
+	if s.sortColumn < 0 || s.comparator == nil {
+		return
+	}
+
+	col := s.sortColumn
+	sort.SliceStable(s.index, func(i, j int) bool {
+		a := cell(s.rows[s.index[i]], col)
+		b := cell(s.rows[s.index[j]], col)
+		if s.sortOrder == SortDesc {
+			return s.comparator(b, a) < 0
+		}
+		return s.comparator(a, b) < 0
+	})
+}
+
+func cell(row []string, i int) string {
+	if i < len(row) {
+		return row[i]
+	}
+	return ""
+}
+
+func matchRow(row []string, query string) bool {
+	for _, value := range row {
+		if strings.Contains(strings.ToLower(value), query) {
+			return true
+		}
+	}
+	return false
+}
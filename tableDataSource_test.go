@@ -0,0 +1,129 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/jroimartin/gocui"
+)
+
+func pagedRows(fetched *[][2]int) func(start, end int) [][]string {
+	return func(start, end int) [][]string {
+		*fetched = append(*fetched, [2]int{start, end})
+
+		rows := make([][]string, 0, end-start)
+		for i := start; i < end; i++ {
+			rows = append(rows, []string{fmt.Sprint(i)})
+		}
+		return rows
+	}
+}
+
+func TestPagedDataSourceRows(t *testing.T) {
+	var fetched [][2]int
+	source := NewPagedDataSource(25, 10, pagedRows(&fetched))
+
+	tests := []struct {
+		start, end int
+		want       []string
+		fetched    [][2]int
+	}{
+		{start: 0, end: 3, want: []string{"0", "1", "2"}, fetched: [][2]int{{0, 10}}},
+		{start: 8, end: 12, want: []string{"8", "9", "10", "11"}, fetched: [][2]int{{0, 10}, {10, 20}}},
+		{start: 5, end: 7, want: []string{"5", "6"}, fetched: [][2]int{{0, 10}, {10, 20}}},
+		{start: 23, end: 30, want: []string{"23", "24"}, fetched: [][2]int{{0, 10}, {10, 20}, {20, 25}}},
+		{start: 30, end: 40, want: nil, fetched: [][2]int{{0, 10}, {10, 20}, {20, 25}}},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, row := range source.Rows(tt.start, tt.end) {
+			got = append(got, row[0])
+		}
+
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Rows(%d, %d) = %v, want %v", tt.start, tt.end, got, tt.want)
+		}
+		if !reflect.DeepEqual(fetched, tt.fetched) {
+			t.Errorf("after Rows(%d, %d) fetched %v, want %v", tt.start, tt.end, fetched, tt.fetched)
+		}
+	}
+
+	source.SetRowCount(5)
+	if got := len(source.Rows(0, 10)); got != 5 {
+		t.Errorf("Rows after SetRowCount(5) returned %d rows", got)
+	}
+	if last := fetched[len(fetched)-1]; last != [2]int{0, 5} {
+		t.Errorf("SetRowCount did not drop fetched pages, last fetch %v", last)
+	}
+}
+
+func TestTableScrollFetchesPage(t *testing.T) {
+	var fetched [][2]int
+	table := NewTable(&gocui.Gui{}, "Jobs", 0, 0, 30, 7).
+		AddColumn("NAME", 10, AlignLeft).
+		SetDataSource(NewPagedDataSource(1000, 50, pagedRows(&fetched)))
+
+	table.writePage(io.Discard)
+	if want := [][2]int{{0, 50}}; !reflect.DeepEqual(fetched, want) {
+		t.Fatalf("first page fetched %v, want %v", fetched, want)
+	}
+
+	// moving in the page does not render rows again
+	if table.scroll(1) {
+		t.Error("scroll in page requires render")
+	}
+
+	for i := 0; i < 20; i++ {
+		if table.scroll(table.pageSize()) {
+			table.writePage(io.Discard)
+		}
+	}
+
+	want := [][2]int{{0, 50}, {50, 100}}
+	if !reflect.DeepEqual(fetched, want) {
+		t.Errorf("scrolling fetched %v, want %v", fetched, want)
+	}
+	if table.offset+table.pageSize() <= table.currentRow {
+		t.Errorf("current row %d is out of page at %d", table.currentRow, table.offset)
+	}
+}
+
+func ExamplePagedDataSource() {
+	// rows are fetched from remote service page by page while table is scrolled
+	fetch := func(start, end int) [][]string {
+		rows := make([][]string, 0, end-start)
+		for i := start; i < end; i++ {
+			rows = append(rows, []string{fmt.Sprintf("job-%d", i), "Done"})
+		}
+		return rows
+	}
+
+	source := NewPagedDataSource(1000000, 100, fetch)
+	fmt.Println(source.Rows(500000, 500002))
+	// Output: [[job-500000 Done] [job-500001 Done]]
+}
+
+func TestTableSetValueFetchesUntilFound(t *testing.T) {
+	var fetched [][2]int
+	table := NewTable(&gocui.Gui{}, "Jobs", 0, 0, 30, 7).
+		AddColumn("NAME", 10, AlignLeft).
+		SetDataSource(NewPagedDataSource(1000, 5, pagedRows(&fetched)))
+
+	if err := table.SetValue([]string{"7"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := table.GetCurrentRow(); got != 7 {
+		t.Errorf("current row = %d, want 7", got)
+	}
+	if want := [][2]int{{0, 5}, {5, 10}}; !reflect.DeepEqual(fetched, want) {
+		t.Errorf("SetValue fetched %v, want %v", fetched, want)
+	}
+
+	if err := table.SetValue([]string{"missing"}); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("SetValue of missing row error = %v, want ErrInvalidValue", err)
+	}
+}
@@ -0,0 +1,49 @@
+package component
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/jroimartin/gocui"
+)
+
+var benchSizes = []int{1e3, 1e5, 1e6}
+
+func benchRows(n int) [][]string {
+	rows := make([][]string, n)
+	for i := range rows {
+		rows[i] = []string{fmt.Sprintf("job-%d", i), "Running", fmt.Sprint(i % 3)}
+	}
+	return rows
+}
+
+func BenchmarkSliceDataSourceRows(b *testing.B) {
+	for _, n := range benchSizes {
+		source := NewSliceDataSource(benchRows(n))
+
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			start := n / 2
+			for i := 0; i < b.N; i++ {
+				source.Rows(start, start+20)
+			}
+		})
+	}
+}
+
+func BenchmarkTableRender(b *testing.B) {
+	for _, n := range benchSizes {
+		table := NewTable(&gocui.Gui{}, "Jobs", 0, 0, 50, 22).
+			AddColumn("NAME", 20, AlignLeft).
+			AddColumn("STATUS", 10, AlignCenter).
+			AddColumn("RESTARTS", 8, AlignRight).
+			SetRows(benchRows(n))
+
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			table.currentRow = n / 2
+			for i := 0; i < b.N; i++ {
+				table.writePage(io.Discard)
+			}
+		})
+	}
+}
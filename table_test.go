@@ -0,0 +1,51 @@
+package component
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jroimartin/gocui"
+)
+
+func TestTableKeepSelectedRow(t *testing.T) {
+	table := NewTable(&gocui.Gui{}, "Users", 0, 0, 30, 5).
+		AddColumn("NAME", 10, AlignLeft).
+		SetDataSource(NewSliceDataSource([][]string{
+			{"alice"}, {"bob"}, {"carol"}, {"dave"}, {"eve"}, {"frank"},
+		}))
+
+	table.currentRow = 1
+	if err := table.Select(nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		apply    func()
+		selected int
+		current  int
+	}{
+		{"sort desc", func() { table.SortBy(0, SortDesc) }, 4, 4},
+		{"sort asc", func() { table.SortBy(0, SortAsc) }, 1, 1},
+		{"filter out", func() { table.Filter("a") }, -1, 0},
+		{"filter in", func() { table.Filter("b") }, 0, 0},
+		{"clear filter", func() { table.Filter("") }, 1, 1},
+	}
+
+	for _, tt := range tests {
+		tt.apply()
+
+		if table.selectedRow != tt.selected {
+			t.Errorf("%s: selected row = %d, want %d", tt.name, table.selectedRow, tt.selected)
+		}
+		if table.currentRow != tt.current {
+			t.Errorf("%s: current row = %d, want %d", tt.name, table.currentRow, tt.current)
+		}
+		if table.currentRow < table.offset || table.currentRow >= table.offset+table.pageSize() {
+			t.Errorf("%s: current row %d is out of page at %d", tt.name, table.currentRow, table.offset)
+		}
+		if got := table.GetSelectedRow(); !reflect.DeepEqual(got, []string{"bob"}) {
+			t.Errorf("%s: selected values = %v", tt.name, got)
+		}
+	}
+}
@@ -0,0 +1,535 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jroimartin/gocui"
+)
+
+// TextArea struct
+type TextArea struct {
+	*gocui.Gui
+	id        string
+	label     *Label
+	field     *Field
+	wrap      bool
+	maxLength int
+	disabled  bool
+	dimmed    bool
+	events
+}
+
+// NewTextArea new text area
+func NewTextArea(gui *gocui.Gui, labelText string, x, y, labelWidth, fieldWidth, height int) *TextArea {
+	if height < 1 {
+		height = 1
+	}
+
+	// label position
+	lp := &Position{
+		x,
+		y,
+		x + labelWidth + 1,
+		y + 2,
+	}
+
+	// field position
+	fp := &Position{
+		lp.W,
+		lp.Y,
+		lp.W + fieldWidth,
+		lp.Y + height + 1,
+	}
+
+	label := &Label{
+		text:     labelText,
+		width:    labelWidth,
+		Position: lp,
+		Attributes: &Attributes{
+			textColor:   gocui.ColorYellow,
+			textBgColor: gocui.ColorDefault,
+		},
+		margin: &Margin{},
+	}
+
+	field := &Field{
+		width:    fieldWidth,
+		Position: fp,
+		Attributes: &Attributes{
+			textColor:   gocui.ColorBlack,
+			textBgColor: gocui.ColorCyan,
+		},
+		handlers:  make(Handlers),
+		margin:    &Margin{},
+		Validator: NewValidator(gui, viewName(labelText, "validator"), fp.X, fp.H-1, fp.W, fp.H+1),
+		editable:  true,
+		ctype:     TypeTextArea,
+	}
+
+	return &TextArea{
+		Gui:   gui,
+		id:    labelText,
+		label: label,
+		field: field,
+	}
+}
+
+// SetID set text area id which is used as view name
+// it must be called before Draw
+func (t *TextArea) SetID(id string) *TextArea {
+	t.id = id
+	t.field.Validator.name = viewName(id, "validator")
+	return t
+}
+
+// GetID get text area id
+func (t *TextArea) GetID() string {
+	return t.id
+}
+
+// AddFieldAttribute add field colors
+func (t *TextArea) AddFieldAttribute(textColor, textBgColor gocui.Attribute) *TextArea {
+	t.field.Attributes = &Attributes{
+		textColor:   textColor,
+		textBgColor: textBgColor,
+	}
+	return t
+}
+
+// AddLabelAttribute add label colors
+func (t *TextArea) AddLabelAttribute(textColor, textBgColor gocui.Attribute) *TextArea {
+	t.label.Attributes = &Attributes{
+		textColor:   textColor,
+		textBgColor: textBgColor,
+	}
+	return t
+}
+
+// AddHandler add keybinding
+func (t *TextArea) AddHandler(key Key, handler Handler) *TextArea {
+	t.field.handlers[key] = handler
+	return t
+}
+
+// AddValidate add text area validator
+func (t *TextArea) AddValidate(errMsg string, validate func(value string) bool) *TextArea {
+	t.field.AddValidate(errMsg, validate)
+	return t
+}
+
+// AddAsyncValidate add validate which takes a long time such as checking server
+func (t *TextArea) AddAsyncValidate(errMsg string, validate func(ctx context.Context, value string) bool) *TextArea {
+	t.field.AddAsyncValidate(errMsg, validate)
+	return t
+}
+
+// SetValidateDebounce set wait time before async validation
+func (t *TextArea) SetValidateDebounce(d time.Duration) *TextArea {
+	t.field.SetDebounce(d)
+	return t
+}
+
+// AddValidates add text area validates such as Required()
+func (t *TextArea) AddValidates(validates ...Validate) *TextArea {
+	t.field.AddValidates(validates...)
+	return t
+}
+
+// SetFieldBorder draw field border
+func (t *TextArea) SetFieldBorder() *TextArea {
+	t.field.drawFrame = true
+	return t
+}
+
+// SetText set text
+func (t *TextArea) SetText(text string) *TextArea {
+	t.field.text = t.cutText(text)
+
+	if v, err := t.Gui.View(t.id); err == nil {
+		v.Clear()
+		fmt.Fprint(v, t.field.text)
+	}
+
+	return t
+}
+
+// SetValue set text, value must be string
+// change handler is called if text is changed
+func (t *TextArea) SetValue(value interface{}) error {
+	text, ok := value.(string)
+	if !ok {
+		return invalidValue(t.id, value)
+	}
+
+	old := t.field.text
+	t.SetText(text)
+
+	if !t.IsValid() {
+		t.field.Validate(t.field.text)
+	}
+
+	if t.field.text != old {
+		return t.emitChange(old, t.field.text)
+	}
+
+	return nil
+}
+
+// GetValue get text
+func (t *TextArea) GetValue() interface{} {
+	return t.field.text
+}
+
+// SetWrap set word wrap
+func (t *TextArea) SetWrap(b bool) *TextArea {
+	t.wrap = b
+
+	if v, err := t.Gui.View(t.id); err == nil {
+		v.Wrap = b
+		v.SetOrigin(0, 0)
+		v.SetCursor(0, 0)
+	}
+
+	return t
+}
+
+// SetWrapKeybinding toggle word wrap with key
+func (t *TextArea) SetWrapKeybinding(key Key) *TextArea {
+	return t.AddHandler(key, func(g *gocui.Gui, v *gocui.View) error {
+		t.SetWrap(!t.wrap)
+		return nil
+	})
+}
+
+// SetMaxLength set max length of text
+// if max length is 0, length is unlimited
+func (t *TextArea) SetMaxLength(length int) *TextArea {
+	t.maxLength = length
+	t.field.text = t.cutText(t.field.text)
+	return t
+}
+
+// SetEditable if editmode is true can input
+func (t *TextArea) SetEditable(b bool) *TextArea {
+	t.field.editable = b
+	return t
+}
+
+// SetDisabled if b is true, text area is dimmed and its handlers are ignored
+func (t *TextArea) SetDisabled(b bool) {
+	t.disabled = b
+	t.applyDisabled()
+	t.emitDisabled()
+}
+
+// IsDisabled return disabled state
+func (t *TextArea) IsDisabled() bool {
+	return t.disabled
+}
+
+// setDimmed dim text area while its fieldset is disabled, its own disabled state is kept
+func (t *TextArea) setDimmed(b bool) {
+	t.dimmed = b
+	t.applyDisabled()
+}
+
+// inactive return true if text area is disabled or dimmed
+func (t *TextArea) inactive() bool {
+	return t.disabled || t.dimmed
+}
+
+// IsWrap return word wrap state
+func (t *TextArea) IsWrap() bool {
+	return t.wrap
+}
+
+// GetFieldText get text area text
+func (t *TextArea) GetFieldText() string {
+	return t.field.text
+}
+
+// GetLabel get label text
+func (t *TextArea) GetLabel() string {
+	return t.label.text
+}
+
+// GetPosition get text area position
+func (t *TextArea) GetPosition() *Position {
+	return t.field.Position
+}
+
+// GetType get component type
+func (t *TextArea) GetType() ComponentType {
+	return t.field.ctype
+}
+
+// GetCursorPosition get line and column of cursor, both start from 0
+func (t *TextArea) GetCursorPosition() (line, col int) {
+	v, err := t.Gui.View(t.id)
+	if err != nil {
+		return 0, 0
+	}
+
+	ox, oy := v.Origin()
+	cx, cy := v.Cursor()
+
+	if !t.wrap {
+		return oy + cy, ox + cx
+	}
+
+	// convert wrapped view line to text line
+	width, _ := v.Size()
+	if width < 1 {
+		return 0, 0
+	}
+
+	y := oy + cy
+	for i, l := range strings.Split(t.field.text, "\n") {
+		n := utf8.RuneCountInString(l)/width + 1
+		if y < n {
+			return i, y*width + cx
+		}
+		y -= n
+	}
+
+	return 0, 0
+}
+
+// Validate validate field
+func (t *TextArea) Validate() bool {
+	t.field.Validate(t.GetFieldText())
+	return t.field.IsValid()
+}
+
+// IsValid valid field data will be return true
+func (t *TextArea) IsValid() bool {
+	return t.field.Validator.IsValid()
+}
+
+// OnChange set handler which is called when text is changed
+func (t *TextArea) OnChange(handler func(old, new interface{})) *TextArea {
+	t.onChange = handler
+	return t
+}
+
+// OnFocus set handler which is called when text area gets focus
+func (t *TextArea) OnFocus(handler func()) *TextArea {
+	t.onFocus = handler
+	return t
+}
+
+// OnBlur set handler which is called when text area loses focus
+func (t *TextArea) OnBlur(handler func()) *TextArea {
+	t.onBlur = handler
+	return t
+}
+
+// Focus focus to text area
+func (t *TextArea) Focus() {
+	t.Gui.Cursor = true
+	t.Gui.SetCurrentView(t.id)
+	t.emitFocus()
+}
+
+// UnFocus un focus
+func (t *TextArea) UnFocus() {
+	t.Gui.Cursor = false
+	t.emitBlur()
+}
+
+// Edit text area editor
+func (t *TextArea) Edit(v *gocui.View, key gocui.Key, ch rune, mod gocui.Modifier) {
+	switch {
+	case ch != 0 && mod == 0:
+		if t.canWrite() {
+			v.EditWrite(ch)
+		}
+	case key == gocui.KeySpace:
+		if t.canWrite() {
+			v.EditWrite(' ')
+		}
+	case key == gocui.KeyEnter:
+		if t.canWrite() {
+			v.EditNewLine()
+		}
+	case key == gocui.KeyBackspace || key == gocui.KeyBackspace2:
+		v.EditDelete(true)
+	case key == gocui.KeyDelete:
+		v.EditDelete(false)
+	case key == gocui.KeyArrowLeft:
+		v.MoveCursor(-1, 0, false)
+	case key == gocui.KeyArrowRight:
+		v.MoveCursor(+1, 0, false)
+	case key == gocui.KeyArrowUp:
+		v.MoveCursor(0, -1, false)
+	case key == gocui.KeyArrowDown:
+		v.MoveCursor(0, +1, false)
+	}
+
+	old := t.field.text
+	t.field.text = strings.TrimSuffix(v.Buffer(), "\n")
+
+	t.field.Validate(t.GetFieldText())
+
+	if t.field.text != old {
+		sendError(t.Gui, t.emitChange(old, t.field.text))
+	}
+}
+
+// Draw draw label and field
+func (t *TextArea) Draw() error {
+	// draw label
+	l := t.label
+	name := viewName(t.id, "label")
+	if v, err := setView(t.Gui, name, l.X, l.Y, l.W, l.H); err != nil {
+		if err != gocui.ErrUnknownView {
+			return newViewError(name, err)
+		}
+
+		v.Frame = false
+		v.FgColor = l.textColor | gocui.AttrBold
+		v.BgColor = l.textBgColor
+
+		fmt.Fprint(v, l.text)
+	}
+
+	// draw field
+	f := t.field
+	if v, err := setView(t.Gui, t.id, f.X, f.Y, f.W, f.H); err != nil {
+		if err != gocui.ErrUnknownView {
+			return newViewError(t.id, err)
+		}
+
+		v.Frame = f.drawFrame
+		v.Wrap = t.wrap
+
+		v.FgColor = f.textColor
+		v.BgColor = f.textBgColor
+
+		v.Editable = f.editable
+		v.Editor = t
+
+		if f.text != "" {
+			fmt.Fprint(v, f.text)
+		}
+
+		// focus text area
+		t.Gui.Cursor = true
+		t.Gui.SetCurrentView(t.id)
+
+		if err := setKeybindings(t.Gui, t.id, ignoreDisabled(f.handlers, t.inactive)); err != nil {
+			return err
+		}
+	}
+
+	t.applyDisabled()
+
+	return nil
+}
+
+// Close close text area
+func (t *TextArea) Close() error {
+	views := []string{
+		t.id,
+		viewName(t.id, "label"),
+	}
+
+	for _, v := range views {
+		if err := deleteView(t.Gui, v); err != nil {
+			return err
+		}
+	}
+
+	t.DeleteKeybindings(t.id)
+	t.field.Validator.StopAsync()
+
+	return t.field.Validator.CloseValidateMsg()
+}
+
+// AddHandlerOnly add handler if key is not used
+// arrow up and down are kept for moving cursor in text area
+func (t *TextArea) AddHandlerOnly(key Key, handler Handler) {
+	if key == gocui.KeyArrowUp || key == gocui.KeyArrowDown {
+		return
+	}
+	if _, ok := t.field.handlers[key]; ok {
+		return
+	}
+	t.AddHandler(key, handler)
+}
+
+func (t *TextArea) getValidator() *Validator {
+	return t.field.Validator
+}
+
+// setPosition move label, field and error message to x, y
+func (t *TextArea) setPosition(x, y int) {
+	dx, dy := x-t.label.X, y-t.label.Y
+	t.label.Position.move(dx, dy)
+	t.field.Position.move(dx, dy)
+	t.field.Validator.Position.move(dx, dy)
+}
+
+// getBounds get area of label and field
+func (t *TextArea) getBounds() *Position {
+	return &Position{t.label.X, t.label.Y, t.field.W, t.field.H}
+}
+
+// setWidth shrink field to fit in width from the left of label
+// field is not wider than the width given to NewTextArea
+func (t *TextArea) setWidth(width int) {
+	fieldWidth := width - (t.field.X - t.label.X)
+	if fieldWidth > t.field.width {
+		fieldWidth = t.field.width
+	}
+	if fieldWidth < 1 {
+		fieldWidth = 1
+	}
+
+	t.field.W = t.field.X + fieldWidth
+}
+
+// applyDisabled change colors of displayed label and field by disabled state
+func (t *TextArea) applyDisabled() {
+	if v, err := t.Gui.View(viewName(t.id, "label")); err == nil {
+		v.FgColor = t.label.textColor | gocui.AttrBold
+		if t.inactive() {
+			v.FgColor = disabledTextColor
+		}
+	}
+
+	if v, err := t.Gui.View(t.id); err == nil {
+		v.FgColor = t.field.textColor
+		v.BgColor = t.field.textBgColor
+		v.Editable = t.field.editable
+		if t.inactive() {
+			v.FgColor = disabledFieldColor
+			v.BgColor = disabledFieldBgColor
+			v.Editable = false
+		}
+	}
+}
+
+func (t *TextArea) canWrite() bool {
+	if t.maxLength <= 0 {
+		return true
+	}
+	return utf8.RuneCountInString(t.field.text) < t.maxLength
+}
+
+func (t *TextArea) cutText(text string) string {
+	if t.maxLength <= 0 {
+		return text
+	}
+
+	runes := []rune(text)
+	if len(runes) > t.maxLength {
+		return string(runes[:t.maxLength])
+	}
+
+	return text
+}
@@ -0,0 +1,49 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/jroimartin/gocui"
+)
+
+func TestTextAreaAddHandlerOnly(t *testing.T) {
+	var called string
+	handler := func(name string) Handler {
+		return func(g *gocui.Gui, v *gocui.View) error {
+			called = name
+			return nil
+		}
+	}
+
+	ta := NewTextArea(&gocui.Gui{}, "Note", 0, 0, 5, 20, 3).
+		AddHandler(gocui.KeyTab, handler("own"))
+
+	ta.AddHandlerOnly(gocui.KeyTab, handler("form"))
+	ta.AddHandlerOnly(gocui.KeyArrowDown, handler("form"))
+	ta.AddHandlerOnly(gocui.KeyCtrlS, handler("form"))
+
+	tests := []struct {
+		key  Key
+		want string
+		ok   bool
+	}{
+		{gocui.KeyTab, "own", true},
+		{gocui.KeyArrowDown, "", false},
+		{gocui.KeyCtrlS, "form", true},
+	}
+
+	for _, tt := range tests {
+		called = ""
+		h, ok := ta.field.handlers[tt.key]
+		if ok != tt.ok {
+			t.Errorf("handler of %v exists = %v, want %v", tt.key, ok, tt.ok)
+			continue
+		}
+		if ok {
+			h(nil, nil)
+		}
+		if called != tt.want {
+			t.Errorf("handler of %v = %q, want %q", tt.key, called, tt.want)
+		}
+	}
+}
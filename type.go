@@ -11,16 +11,95 @@ type Handler func(g *gocui.Gui, v *gocui.View) error
 // Handlers handlers
 type Handlers map[Key]Handler
 
+// colors of disabled component
+const (
+	disabledTextColor    = gocui.ColorBlack | gocui.AttrBold
+	disabledFieldColor   = gocui.ColorBlack
+	disabledFieldBgColor = gocui.ColorWhite
+)
+
 // Component form component interface
 type Component interface {
+	GetID() string
 	GetLabel() string
 	GetPosition() *Position
 	GetType() ComponentType
 	Focus()
 	UnFocus()
-	Draw()
-	Close()
+	Draw() error
+	Close() error
 	AddHandlerOnly(Key, Handler)
+	SetDisabled(bool)
+	IsDisabled() bool
+}
+
+// Validatable component which can validate its value
+type Validatable interface {
+	Validate() bool
+	IsValid() bool
+}
+
+// Valuer component which has value such as text or checked state
+// value types are the same as form data
+type Valuer interface {
+	GetValue() interface{}
+	SetValue(value interface{}) error
+}
+
+// validatorHolder component which display error message with validator
+type validatorHolder interface {
+	getValidator() *Validator
+}
+
+// placeable component which can be moved by form layout
+type placeable interface {
+	setPosition(x, y int)
+	getBounds() *Position
+}
+
+// resizable component whose width can be shrunk by form layout
+type resizable interface {
+	setWidth(width int)
+}
+
+// changeNotifier component which tells form that its value is changed
+type changeNotifier interface {
+	setNotify(notify func(old, new interface{}) error)
+}
+
+// disableNotifier component which tells form that its disabled state is changed
+type disableNotifier interface {
+	setDisableNotify(notify func())
+}
+
+// dimmer component which is dimmed while its fieldset is disabled
+type dimmer interface {
+	setDimmed(b bool)
+}
+
+// ignoreDisabled wrap handlers so that they do nothing while component is disabled
+func ignoreDisabled(handlers Handlers, disabled func() bool) Handlers {
+	wrapped := make(Handlers, len(handlers))
+	for key, handler := range handlers {
+		handler := handler
+		wrapped[key] = func(g *gocui.Gui, v *gocui.View) error {
+			if disabled() {
+				return nil
+			}
+			return handler(g, v)
+		}
+	}
+	return wrapped
+}
+
+// scopedID get id of component which belongs to scope such as form
+func scopedID(scope, label string) string {
+	return scope + "/" + label
+}
+
+// viewName get view name of component part
+func viewName(id, part string) string {
+	return id + ":" + part
 }
 
 // Attributes text and hilight color
@@ -37,6 +116,14 @@ type Position struct {
 	W, H int
 }
 
+// move move position keeping its size
+func (p *Position) move(dx, dy int) {
+	p.X += dx
+	p.Y += dy
+	p.W += dx
+	p.H += dy
+}
+
 // ComponentType component type
 type ComponentType int
 
@@ -53,4 +140,27 @@ const (
 	TypeRadio
 	// TypeTable type is table component
 	TypeTable
+	// TypeTextArea type is text area component
+	TypeTextArea
+	// TypeFieldset type is fieldset component
+	TypeFieldset
 )
+
+var componentTypeNames = map[ComponentType]string{
+	TypeInputField: "InputField",
+	TypeSelect:     "Select",
+	TypeButton:     "Button",
+	TypeCheckBox:   "CheckBox",
+	TypeRadio:      "Radio",
+	TypeTable:      "Table",
+	TypeTextArea:   "TextArea",
+	TypeFieldset:   "Fieldset",
+}
+
+// String get component type name
+func (t ComponentType) String() string {
+	if name, ok := componentTypeNames[t]; ok {
+		return name
+	}
+	return "Unknown"
+}
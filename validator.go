@@ -1,33 +1,60 @@
 package component
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/jroimartin/gocui"
 )
 
+const defaultDebounce = 300 * time.Millisecond
+
 // Validate validate struct
 type Validate struct {
+	ErrMsg     string
+	Do         func(value string) bool
+	errMsgFunc func(value string) string
+}
+
+// AsyncValidate async validate struct
+// Do is called in other goroutine and ctx is canceled when value is changed
+type AsyncValidate struct {
 	ErrMsg string
-	Do     func(value string) bool
+	Do     func(ctx context.Context, value string) bool
 }
 
 // Validator validate struct
 type Validator struct {
 	*gocui.Gui
-	name      string
-	errMsg    string
-	isValid   bool
-	validates []Validate
+	name           string
+	errMsg         string
+	isValid        bool
+	validates      []Validate
+	asyncValidates []AsyncValidate
+	debounce       time.Duration
+	async          *asyncState
+	update         func(func(*gocui.Gui) error)
 	*Position
 }
 
+// asyncState state of async validation for value
+type asyncState struct {
+	value   string
+	pending bool
+	errMsg  string
+	cancel  context.CancelFunc
+	timer   *time.Timer
+}
+
 // NewValidator new validator
 func NewValidator(gui *gocui.Gui, name string, x, y, w, h int) *Validator {
 	return &Validator{
-		Gui:     gui,
-		name:    name,
-		isValid: true,
+		Gui:      gui,
+		name:     name,
+		isValid:  true,
+		debounce: defaultDebounce,
+		update:   gui.Update,
 		Position: &Position{
 			X: x,
 			Y: y,
@@ -49,42 +76,193 @@ func (v *Validator) AddValidate(errMsg string, validate func(value string) bool)
 	}
 }
 
+// AddValidates add validates such as Required()
+func (v *Validator) AddValidates(validates ...Validate) {
+	v.validates = append(v.validates, validates...)
+}
+
+// AddAsyncValidate add validate which takes a long time
+// it runs after sync validates passed and value is not changed during debounce
+func (v *Validator) AddAsyncValidate(errMsg string, validate func(ctx context.Context, value string) bool) {
+	v.asyncValidates = append(v.asyncValidates, AsyncValidate{
+		ErrMsg: errMsg,
+		Do:     validate,
+	})
+}
+
+// SetDebounce set wait time before async validation
+func (v *Validator) SetDebounce(d time.Duration) {
+	v.debounce = d
+}
+
 // DispValidateMsg display validate error message
-func (v *Validator) DispValidateMsg() {
-	if vi, err := v.SetView(v.name, v.X, v.Y, v.W, v.H); err != nil {
+func (v *Validator) DispValidateMsg() error {
+	return v.dispMsg(v.errMsg, gocui.ColorRed)
+}
+
+func (v *Validator) dispMsg(msg string, color gocui.Attribute) error {
+	if v.X+len(msg) > v.W {
+		v.W = v.X + len(msg) + 1
+	}
+
+	vi, err := setView(v.Gui, v.name, v.X, v.Y, v.W, v.H)
+	if err != nil {
 		if err != gocui.ErrUnknownView {
-			panic(err)
+			return newViewError(v.name, err)
 		}
 
 		vi.Frame = false
 		vi.BgColor = gocui.ColorDefault
-		vi.FgColor = gocui.ColorRed
+	}
 
-		fmt.Fprint(vi, v.errMsg)
+	vi.FgColor = color
+	vi.Clear()
+	fmt.Fprint(vi, msg)
+
+	return nil
+}
+
+// moveMsg move displayed message to current position
+func (v *Validator) moveMsg() error {
+	if _, err := v.View(v.name); err != nil {
+		return nil
 	}
+
+	if _, err := setView(v.Gui, v.name, v.X, v.Y, v.W, v.H); err != nil {
+		return newViewError(v.name, err)
+	}
+
+	return nil
+}
+
+// SetError display error message and set invalid
+// the state is changed even if the message cannot be displayed
+func (v *Validator) SetError(errMsg string) error {
+	v.errMsg = errMsg
+	v.isValid = false
+	return v.DispValidateMsg()
+}
+
+// GetErrMsg get current error message
+func (v *Validator) GetErrMsg() string {
+	if v.IsPending() {
+		return validateMessage("checking")
+	}
+	if v.isValid {
+		return ""
+	}
+	return v.errMsg
 }
 
 // CloseValidateMsg close validate error message
-func (v *Validator) CloseValidateMsg() {
-	v.DeleteView(v.name)
+func (v *Validator) CloseValidateMsg() error {
+	return deleteView(v.Gui, v.name)
+}
+
+// clear stop validation and close error message
+func (v *Validator) clear() error {
+	v.StopAsync()
+	v.isValid = true
+	v.errMsg = ""
+	return v.CloseValidateMsg()
 }
 
 // IsValid if valid return true
+// it returns false while async validation is running
 func (v *Validator) IsValid() bool {
-	return v.isValid
+	return v.isValid && !v.IsPending()
+}
+
+// IsPending if async validation is running return true
+func (v *Validator) IsPending() bool {
+	return v.async != nil && v.async.pending
 }
 
 // Validate validate value
 func (v *Validator) Validate(value string) {
 	for _, validate := range v.validates {
 		if !validate.Do(value) {
-			v.errMsg = validate.ErrMsg
-			v.isValid = false
-			v.DispValidateMsg()
-			break
-		} else {
-			v.isValid = true
-			v.CloseValidateMsg()
+			v.StopAsync()
+			v.SetError(validate.message(value))
+			return
 		}
 	}
+
+	if len(v.asyncValidates) == 0 {
+		v.isValid = true
+		v.CloseValidateMsg()
+		return
+	}
+
+	// value is already checked or being checked
+	if a := v.async; a != nil && a.value == value {
+		v.showAsyncResult()
+		return
+	}
+
+	v.startAsync(value)
+}
+
+// StopAsync cancel running async validation
+func (v *Validator) StopAsync() {
+	if v.async == nil {
+		return
+	}
+
+	v.async.timer.Stop()
+	v.async.cancel()
+	v.async = nil
+}
+
+func (v *Validator) startAsync(value string) {
+	v.StopAsync()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a := &asyncState{
+		value:   value,
+		pending: true,
+		cancel:  cancel,
+	}
+	v.async = a
+
+	validates := v.asyncValidates
+	a.timer = time.AfterFunc(v.debounce, func() {
+		var errMsg string
+		for _, validate := range validates {
+			if !validate.Do(ctx, value) {
+				errMsg = validate.ErrMsg
+				break
+			}
+		}
+
+		v.update(func(g *gocui.Gui) error {
+			// value was changed while validating
+			if ctx.Err() != nil || v.async != a {
+				return nil
+			}
+
+			a.pending = false
+			a.errMsg = errMsg
+			v.showAsyncResult()
+
+			return nil
+		})
+	})
+
+	v.showAsyncResult()
+}
+
+func (v *Validator) showAsyncResult() {
+	a := v.async
+
+	switch {
+	case a.pending:
+		v.isValid = true
+		v.dispMsg(validateMessage("checking"), gocui.ColorYellow)
+	case a.errMsg != "":
+		v.SetError(a.errMsg)
+	default:
+		v.isValid = true
+		v.CloseValidateMsg()
+	}
 }
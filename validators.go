@@ -0,0 +1,280 @@
+package component
+
+import (
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ValidateMessages default error messages of each language
+var ValidateMessages = map[string]map[string]string{
+	"en": {
+		"required":   "required input",
+		"minLength":  "must be at least %d characters",
+		"maxLength":  "must be at most %d characters",
+		"match":      "invalid format",
+		"intRange":   "must be an integer between %d and %d",
+		"floatRange": "must be a number between %g and %g",
+		"email":      "invalid email address",
+		"url":        "invalid url",
+		"ipv4":       "invalid IPv4 address",
+		"ipv6":       "invalid IPv6 address",
+		"cidr":       "invalid CIDR",
+		"hostname":   "invalid hostname",
+		"port":       "must be a port number between 1 and 65535",
+		"fileExists": "file does not exist",
+		"duration":   "invalid duration such as 10s, 5m",
+		"not":        "invalid value",
+		"checking":   "checking\u2026",
+	},
+	"ja": {
+		"required":   "入力必須です",
+		"minLength":  "%d文字以上で入力してください",
+		"maxLength":  "%d文字以下で入力してください",
+		"match":      "形式が正しくありません",
+		"intRange":   "%dから%dの整数を入力してください",
+		"floatRange": "%gから%gの数値を入力してください",
+		"email":      "メールアドレスが正しくありません",
+		"url":        "URLが正しくありません",
+		"ipv4":       "IPv4アドレスが正しくありません",
+		"ipv6":       "IPv6アドレスが正しくありません",
+		"cidr":       "CIDRが正しくありません",
+		"hostname":   "ホスト名が正しくありません",
+		"port":       "1から65535のポート番号を入力してください",
+		"fileExists": "ファイルが存在しません",
+		"duration":   "10s, 5mのような期間を入力してください",
+		"not":        "値が正しくありません",
+		"checking":   "確認中\u2026",
+	},
+}
+
+var validateLanguage = "en"
+
+var hostnameRegexp = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*\.?$`)
+
+// SetValidateLanguage set language of default error messages
+// it affects validators created after calling it
+func SetValidateLanguage(lang string) {
+	if _, ok := ValidateMessages[lang]; ok {
+		validateLanguage = lang
+	}
+}
+
+func validateMessage(key string, args ...interface{}) string {
+	msg, ok := ValidateMessages[validateLanguage][key]
+	if !ok {
+		msg = ValidateMessages["en"][key]
+	}
+
+	if len(args) == 0 {
+		return msg
+	}
+
+	return fmt.Sprintf(msg, args...)
+}
+
+// newValidate new validate which is passed when value is empty
+func newValidate(errMsg string, validate func(value string) bool) Validate {
+	return Validate{
+		ErrMsg: errMsg,
+		Do: func(value string) bool {
+			if value == "" {
+				return true
+			}
+			return validate(value)
+		},
+	}
+}
+
+// WithMessage replace error message
+func (v Validate) WithMessage(errMsg string) Validate {
+	v.ErrMsg = errMsg
+	v.errMsgFunc = nil
+	return v
+}
+
+// message get error message for value
+func (v Validate) message(value string) string {
+	if v.errMsgFunc != nil {
+		return v.errMsgFunc(value)
+	}
+	return v.ErrMsg
+}
+
+// Required value must not be empty
+//
+// other validators pass empty value, use them with Required if input is required
+func Required() Validate {
+	return Validate{
+		ErrMsg: validateMessage("required"),
+		Do: func(value string) bool {
+			return strings.TrimSpace(value) != ""
+		},
+	}
+}
+
+// MinLength value must have at least min characters
+func MinLength(min int) Validate {
+	return newValidate(validateMessage("minLength", min), func(value string) bool {
+		return utf8.RuneCountInString(value) >= min
+	})
+}
+
+// MaxLength value must have at most max characters
+func MaxLength(max int) Validate {
+	return newValidate(validateMessage("maxLength", max), func(value string) bool {
+		return utf8.RuneCountInString(value) <= max
+	})
+}
+
+// Match value must match regular expression
+func Match(re *regexp.Regexp) Validate {
+	return newValidate(validateMessage("match"), re.MatchString)
+}
+
+// IntRange value must be integer in [min, max]
+func IntRange(min, max int) Validate {
+	return newValidate(validateMessage("intRange", min, max), func(value string) bool {
+		n, err := strconv.Atoi(value)
+		return err == nil && min <= n && n <= max
+	})
+}
+
+// FloatRange value must be number in [min, max]
+func FloatRange(min, max float64) Validate {
+	return newValidate(validateMessage("floatRange", min, max), func(value string) bool {
+		n, err := strconv.ParseFloat(value, 64)
+		return err == nil && min <= n && n <= max
+	})
+}
+
+// Email value must be email address
+func Email() Validate {
+	return newValidate(validateMessage("email"), func(value string) bool {
+		addr, err := mail.ParseAddress(value)
+		return err == nil && addr.Address == value
+	})
+}
+
+// URL value must be absolute url
+func URL() Validate {
+	return newValidate(validateMessage("url"), func(value string) bool {
+		u, err := url.ParseRequestURI(value)
+		return err == nil && u.Scheme != "" && u.Host != ""
+	})
+}
+
+// IPv4 value must be IPv4 address
+func IPv4() Validate {
+	return newValidate(validateMessage("ipv4"), func(value string) bool {
+		ip := net.ParseIP(value)
+		return ip != nil && ip.To4() != nil && !strings.Contains(value, ":")
+	})
+}
+
+// IPv6 value must be IPv6 address
+func IPv6() Validate {
+	return newValidate(validateMessage("ipv6"), func(value string) bool {
+		ip := net.ParseIP(value)
+		return ip != nil && strings.Contains(value, ":")
+	})
+}
+
+// CIDR value must be CIDR notation such as 192.168.0.0/24
+func CIDR() Validate {
+	return newValidate(validateMessage("cidr"), func(value string) bool {
+		_, _, err := net.ParseCIDR(value)
+		return err == nil
+	})
+}
+
+// Hostname value must be hostname
+func Hostname() Validate {
+	return newValidate(validateMessage("hostname"), func(value string) bool {
+		return len(value) <= 253 && hostnameRegexp.MatchString(value)
+	})
+}
+
+// Port value must be port number
+func Port() Validate {
+	return newValidate(validateMessage("port"), func(value string) bool {
+		n, err := strconv.Atoi(value)
+		return err == nil && 1 <= n && n <= 65535
+	})
+}
+
+// FileExists value must be path of existing file or directory
+func FileExists() Validate {
+	return newValidate(validateMessage("fileExists"), func(value string) bool {
+		_, err := os.Stat(value)
+		return err == nil
+	})
+}
+
+// Duration value must be duration such as 10s
+func Duration() Validate {
+	return newValidate(validateMessage("duration"), func(value string) bool {
+		_, err := time.ParseDuration(value)
+		return err == nil
+	})
+}
+
+// And all validates must pass
+// error message is the message of the first failed validate
+func And(validates ...Validate) Validate {
+	return Validate{
+		Do: func(value string) bool {
+			for _, v := range validates {
+				if !v.Do(value) {
+					return false
+				}
+			}
+			return true
+		},
+		errMsgFunc: func(value string) string {
+			for _, v := range validates {
+				if !v.Do(value) {
+					return v.message(value)
+				}
+			}
+			return ""
+		},
+	}
+}
+
+// Or one of validates must pass
+// error message is the messages of all validates
+func Or(validates ...Validate) Validate {
+	return Validate{
+		Do: func(value string) bool {
+			for _, v := range validates {
+				if v.Do(value) {
+					return true
+				}
+			}
+			return false
+		},
+		errMsgFunc: func(value string) string {
+			msgs := make([]string, len(validates))
+			for i, v := range validates {
+				msgs[i] = v.message(value)
+			}
+			return strings.Join(msgs, " or ")
+		},
+	}
+}
+
+// Not validate must fail
+// empty value passes like other validators
+func Not(validate Validate) Validate {
+	return newValidate(validateMessage("not"), func(value string) bool {
+		return !validate.Do(value)
+	})
+}
@@ -0,0 +1,344 @@
+package component
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jroimartin/gocui"
+)
+
+type validateTest struct {
+	value string
+	want  bool
+}
+
+func testValidate(t *testing.T, name string, v Validate, tests []validateTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		if got := v.Do(tt.value); got != tt.want {
+			t.Errorf("%s(%q) = %v, want %v", name, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestValidators(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		validate Validate
+		tests    []validateTest
+	}{
+		{
+			name:     "Required",
+			validate: Required(),
+			tests:    []validateTest{{"a", true}, {"", false}, {"  ", false}},
+		},
+		{
+			name:     "MinLength",
+			validate: MinLength(3),
+			tests:    []validateTest{{"", true}, {"ab", false}, {"abc", true}, {"あいう", true}},
+		},
+		{
+			name:     "MaxLength",
+			validate: MaxLength(3),
+			tests:    []validateTest{{"", true}, {"abc", true}, {"abcd", false}, {"あいう", true}},
+		},
+		{
+			name:     "Match",
+			validate: Match(regexp.MustCompile(`^[a-z]+$`)),
+			tests:    []validateTest{{"", true}, {"abc", true}, {"ab1", false}},
+		},
+		{
+			name:     "IntRange",
+			validate: IntRange(-1, 10),
+			tests:    []validateTest{{"", true}, {"-1", true}, {"10", true}, {"11", false}, {"-2", false}, {"1.5", false}, {"x", false}},
+		},
+		{
+			name:     "FloatRange",
+			validate: FloatRange(0, 1),
+			tests:    []validateTest{{"", true}, {"0", true}, {"0.5", true}, {"1", true}, {"1.01", false}, {"-0.1", false}, {"x", false}},
+		},
+		{
+			name:     "Email",
+			validate: Email(),
+			tests:    []validateTest{{"", true}, {"a@example.com", true}, {"a@", false}, {"A <a@example.com>", false}, {"example.com", false}},
+		},
+		{
+			name:     "URL",
+			validate: URL(),
+			tests:    []validateTest{{"", true}, {"https://example.com/a?b=c", true}, {"example.com", false}, {"http://", false}, {"/path", false}},
+		},
+		{
+			name:     "IPv4",
+			validate: IPv4(),
+			tests:    []validateTest{{"", true}, {"192.168.0.1", true}, {"256.0.0.1", false}, {"::1", false}, {"::ffff:192.168.0.1", false}},
+		},
+		{
+			name:     "IPv6",
+			validate: IPv6(),
+			tests:    []validateTest{{"", true}, {"::1", true}, {"fe80::1", true}, {"192.168.0.1", false}, {"fe80::g", false}},
+		},
+		{
+			name:     "CIDR",
+			validate: CIDR(),
+			tests:    []validateTest{{"", true}, {"10.0.0.0/8", true}, {"fd00::/64", true}, {"10.0.0.0", false}, {"10.0.0.0/33", false}},
+		},
+		{
+			name:     "Hostname",
+			validate: Hostname(),
+			tests:    []validateTest{{"", true}, {"localhost", true}, {"a.example.com.", true}, {"-a.com", false}, {"a_b.com", false}, {"a..com", false}},
+		},
+		{
+			name:     "Port",
+			validate: Port(),
+			tests:    []validateTest{{"", true}, {"1", true}, {"65535", true}, {"0", false}, {"65536", false}, {"http", false}},
+		},
+		{
+			name:     "FileExists",
+			validate: FileExists(),
+			tests:    []validateTest{{"", true}, {file, true}, {dir, true}, {filepath.Join(dir, "missing"), false}},
+		},
+		{
+			name:     "Duration",
+			validate: Duration(),
+			tests:    []validateTest{{"", true}, {"10s", true}, {"1h30m", true}, {"10", false}, {"ten", false}},
+		},
+	}
+
+	for _, tt := range tests {
+		testValidate(t, tt.name, tt.validate, tt.tests)
+	}
+}
+
+func TestCombinators(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate Validate
+		tests    []validateTest
+	}{
+		{
+			name:     "And",
+			validate: And(MinLength(2), Match(regexp.MustCompile(`^[a-z]+$`))),
+			tests:    []validateTest{{"", true}, {"ab", true}, {"a", false}, {"a1", false}},
+		},
+		{
+			name:     "Or",
+			validate: Or(IPv4(), Hostname()),
+			tests:    []validateTest{{"", true}, {"10.0.0.1", true}, {"example.com", true}, {"a_b", false}},
+		},
+		{
+			name:     "Not",
+			validate: Not(Match(regexp.MustCompile(`^admin$`))),
+			tests:    []validateTest{{"", true}, {"user", true}, {"admin", false}},
+		},
+		{
+			name:     "NotEmail",
+			validate: Not(Email()),
+			tests:    []validateTest{{"", true}, {"name", true}, {"a@example.com", false}},
+		},
+		{
+			name:     "AndRequired",
+			validate: And(Required(), Not(Email())),
+			tests:    []validateTest{{"", false}, {"name", true}, {"a@example.com", false}},
+		},
+	}
+
+	for _, tt := range tests {
+		testValidate(t, tt.name, tt.validate, tt.tests)
+	}
+}
+
+func TestValidateMessages(t *testing.T) {
+	and := And(Required(), MinLength(3))
+	tests := []struct {
+		validate Validate
+		value    string
+		want     string
+	}{
+		{validate: and, value: "", want: "required input"},
+		{validate: and, value: "ab", want: "must be at least 3 characters"},
+		{validate: Or(IPv4(), IPv6()), value: "x", want: "invalid IPv4 address or invalid IPv6 address"},
+		{validate: Not(Email()), value: "a@example.com", want: "invalid value"},
+		{validate: IntRange(1, 5).WithMessage("1-5"), value: "9", want: "1-5"},
+		{validate: And(Port()).WithMessage("bad port"), value: "0", want: "bad port"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.validate.message(tt.value); got != tt.want {
+			t.Errorf("message(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestSetValidateLanguage(t *testing.T) {
+	defer SetValidateLanguage("en")
+
+	SetValidateLanguage("ja")
+	if got, want := MaxLength(5).ErrMsg, "5文字以下で入力してください"; got != want {
+		t.Errorf("ja message = %q, want %q", got, want)
+	}
+
+	// unknown language is ignored
+	SetValidateLanguage("xx")
+	if got, want := Required().ErrMsg, "入力必須です"; got != want {
+		t.Errorf("message after unknown language = %q, want %q", got, want)
+	}
+
+	SetValidateLanguage("en")
+	if got, want := Port().ErrMsg, "must be a port number between 1 and 65535"; got != want {
+		t.Errorf("en message = %q, want %q", got, want)
+	}
+}
+
+// newAsyncValidator new validator whose results are sent to updates instead of main loop
+func newAsyncValidator(updates chan func(*gocui.Gui) error) *Validator {
+	v := NewValidator(&gocui.Gui{}, "name:validator", 0, 1, 10, 2)
+	v.update = func(f func(*gocui.Gui) error) {
+		updates <- f
+	}
+	return v
+}
+
+// runUpdate run the next result of async validation as main loop does
+func runUpdate(t *testing.T, updates chan func(*gocui.Gui) error) {
+	t.Helper()
+
+	select {
+	case f := <-updates:
+		if err := f(nil); err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("async validation did not finish")
+	}
+}
+
+func TestAsyncValidateDebounce(t *testing.T) {
+	updates := make(chan func(*gocui.Gui) error, 1)
+	checked := make(chan string, 3)
+
+	v := newAsyncValidator(updates)
+	v.SetDebounce(50 * time.Millisecond)
+	v.AddAsyncValidate("taken", func(ctx context.Context, value string) bool {
+		checked <- value
+		return value != "gorilla"
+	})
+
+	for _, value := range []string{"g", "go", "gorilla"} {
+		v.Validate(value)
+	}
+
+	if !v.IsPending() || v.IsValid() {
+		t.Errorf("pending = %v, valid = %v while checking", v.IsPending(), v.IsValid())
+	}
+	if got, want := v.GetErrMsg(), validateMessage("checking"); got != want {
+		t.Errorf("GetErrMsg() = %q, want %q", got, want)
+	}
+
+	runUpdate(t, updates)
+
+	if len(checked) != 1 {
+		t.Fatalf("async validate was called %d times, want 1", len(checked))
+	}
+	if got := <-checked; got != "gorilla" {
+		t.Errorf("async validate was called with %q", got)
+	}
+	if v.IsPending() || v.IsValid() || v.GetErrMsg() != "taken" {
+		t.Errorf("pending = %v, valid = %v, message = %q, want taken", v.IsPending(), v.IsValid(), v.GetErrMsg())
+	}
+}
+
+func TestAsyncValidateSuperseded(t *testing.T) {
+	updates := make(chan func(*gocui.Gui) error, 2)
+	started := make(chan string, 2)
+	canceled := make(chan bool, 1)
+	release := map[string]chan struct{}{
+		"old": make(chan struct{}),
+		"new": make(chan struct{}),
+	}
+
+	v := newAsyncValidator(updates)
+	v.SetDebounce(time.Millisecond)
+	v.AddAsyncValidate("taken", func(ctx context.Context, value string) bool {
+		started <- value
+		<-release[value]
+		if value == "old" {
+			canceled <- ctx.Err() != nil
+		}
+		return value == "old"
+	})
+
+	v.Validate("old")
+	if got := <-started; got != "old" {
+		t.Fatalf("started %q", got)
+	}
+
+	v.Validate("new")
+	if got := <-started; got != "new" {
+		t.Fatalf("started %q", got)
+	}
+
+	// result of old value is dropped
+	close(release["old"])
+	if !<-canceled {
+		t.Error("context of old value is not canceled")
+	}
+	runUpdate(t, updates)
+	if !v.IsPending() {
+		t.Fatal("result of old value finished validation of new value")
+	}
+
+	close(release["new"])
+	runUpdate(t, updates)
+	if v.IsPending() || v.IsValid() || v.GetErrMsg() != "taken" {
+		t.Errorf("pending = %v, valid = %v, message = %q, want taken", v.IsPending(), v.IsValid(), v.GetErrMsg())
+	}
+}
+
+func TestAsyncValidateResult(t *testing.T) {
+	updates := make(chan func(*gocui.Gui) error, 1)
+
+	v := newAsyncValidator(updates)
+	v.SetDebounce(time.Millisecond)
+	v.AddValidates(Required())
+	v.AddAsyncValidate("taken", func(ctx context.Context, value string) bool {
+		return value != "gorilla"
+	})
+
+	v.Validate("zoo")
+	runUpdate(t, updates)
+	if v.IsPending() || !v.IsValid() || v.GetErrMsg() != "" {
+		t.Errorf("pending = %v, valid = %v, message = %q, want valid", v.IsPending(), v.IsValid(), v.GetErrMsg())
+	}
+	if _, err := v.View(v.name); err == nil {
+		t.Error("message is displayed for valid value")
+	}
+
+	// checked value is not validated again
+	v.Validate("zoo")
+	if v.IsPending() || !v.IsValid() {
+		t.Errorf("pending = %v, valid = %v for checked value", v.IsPending(), v.IsValid())
+	}
+
+	// sync validation stops async validation
+	v.Validate("gorilla")
+	v.Validate("")
+	if v.IsPending() || v.IsValid() {
+		t.Errorf("pending = %v, valid = %v after required failed", v.IsPending(), v.IsValid())
+	}
+	select {
+	case <-updates:
+		t.Error("stopped async validation sent result")
+	case <-time.After(20 * time.Millisecond):
+	}
+}
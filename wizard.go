@@ -0,0 +1,270 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/jroimartin/gocui"
+)
+
+// Wizard forms which are displayed step by step
+type Wizard struct {
+	*gocui.Gui
+	name     string
+	steps    []*WizardStep
+	current  int
+	history  []int
+	onFinish func(fd *FormData) error
+	*Position
+}
+
+// WizardStep form of wizard step
+type WizardStep struct {
+	*Form
+	title string
+	key   string
+	skip  func(fd *FormData) bool
+	back  *Button
+	next  *Button
+}
+
+// NewWizard new wizard
+func NewWizard(gui *gocui.Gui, name string, x, y, w, h int) *Wizard {
+	return &Wizard{
+		Gui:  gui,
+		name: name,
+		Position: &Position{
+			X: x,
+			Y: y,
+			W: w,
+			H: h,
+		},
+	}
+}
+
+// AddStep add step, components are added to the returned form
+// Back and Next buttons are added after the components
+// steps which have the same title are numbered such as "Address#2"
+func (w *Wizard) AddStep(title string) *WizardStep {
+	key := w.stepKey(title)
+	step := &WizardStep{
+		Form:  NewForm(w.Gui, scopedID(w.name, key), w.X, w.Y, w.W, w.H),
+		title: title,
+		key:   key,
+	}
+
+	w.steps = append(w.steps, step)
+
+	return step
+}
+
+// stepKey get unique key of new step
+func (w *Wizard) stepKey(title string) string {
+	n := 1
+	for _, step := range w.steps {
+		if step.title == title {
+			n++
+		}
+	}
+
+	if n > 1 {
+		return fmt.Sprintf("%s#%d", title, n)
+	}
+	return title
+}
+
+// SkipIf skip step when skip returns true
+// fd has answers of the previous steps, see GetFormData for the keys
+func (s *WizardStep) SkipIf(skip func(fd *FormData) bool) *WizardStep {
+	s.skip = skip
+	return s
+}
+
+// OnFinish set function which is called with answers of all steps when Finish is pressed
+func (w *Wizard) OnFinish(f func(fd *FormData) error) *Wizard {
+	w.onFinish = f
+	return w
+}
+
+// GetFormData get answers of the steps which have been displayed
+// keys are qualified with the step key such as "Account.Email", and skipped steps are not included
+func (w *Wizard) GetFormData() *FormData {
+	fd := NewFormData()
+	if len(w.steps) == 0 {
+		return fd
+	}
+
+	for _, i := range append(w.history, w.current) {
+		step := w.steps[i]
+		for _, field := range step.GetFormData().Fields() {
+			fd.Add(step.key+"."+field.Label, field.Type, field.Value)
+		}
+	}
+
+	return fd
+}
+
+// GetCurrentStep get step which is displayed
+func (w *Wizard) GetCurrentStep() *WizardStep {
+	if len(w.steps) == 0 {
+		return nil
+	}
+	return w.steps[w.current]
+}
+
+// Draw display the first step
+func (w *Wizard) Draw() error {
+	if len(w.steps) == 0 {
+		return &ViewError{View: w.name, Err: ErrNoSteps}
+	}
+
+	w.history = nil
+
+	first := w.nextStep(-1, NewFormData())
+	if first < 0 {
+		return &ViewError{View: w.name, Err: ErrNoSteps}
+	}
+
+	return w.show(first)
+}
+
+// Close close the current step
+func (w *Wizard) Close() error {
+	if len(w.steps) == 0 {
+		return nil
+	}
+
+	step := w.steps[w.current]
+	if _, err := w.Gui.View(step.name); err != nil {
+		return nil
+	}
+
+	return step.Close(w.Gui, nil)
+}
+
+// Layout update step indicator and layout the current step
+// it implements gocui.Manager
+//
+//	gui.SetManager(wizard)
+func (w *Wizard) Layout(g *gocui.Gui) error {
+	if len(w.steps) == 0 {
+		return nil
+	}
+
+	step := w.steps[w.current]
+	if step.next == nil {
+		return nil
+	}
+
+	if err := w.refresh(); err != nil {
+		return err
+	}
+
+	return step.Layout(g)
+}
+
+// show close the current step and draw the step
+func (w *Wizard) show(index int) error {
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	w.current = index
+	step := w.steps[index]
+
+	if step.next == nil {
+		step.back = step.AddButton("Back", w.back)
+		step.next = step.AddButton("Next", w.next)
+	}
+
+	// there is no step to go back from the first step
+	step.back.SetDisabled(len(w.history) == 0)
+
+	if err := w.refresh(); err != nil {
+		return err
+	}
+
+	return step.Draw()
+}
+
+// refresh update step indicator and label of Next button, and redraw the step if they are changed
+// answers of the current step may change the following steps
+func (w *Wizard) refresh() error {
+	step := w.steps[w.current]
+	fd := w.GetFormData()
+
+	total := len(w.history) + 1
+	for i := w.nextStep(w.current, fd); i >= 0; i = w.nextStep(i, fd) {
+		total++
+	}
+
+	title := fmt.Sprintf("%s (Step %d of %d)", step.title, len(w.history)+1, total)
+
+	label := "Next"
+	if total == len(w.history)+1 {
+		label = "Finish"
+	}
+
+	if title == step.Form.title && label == step.next.label {
+		return nil
+	}
+
+	step.SetTitle(title)
+	step.next.setLabel(label)
+
+	if _, err := w.Gui.View(step.name); err != nil {
+		return nil
+	}
+
+	step.layout()
+	return step.draw()
+}
+
+// nextStep get index of step after from which is not skipped
+// return -1 if there is no step
+func (w *Wizard) nextStep(from int, fd *FormData) int {
+	for i := from + 1; i < len(w.steps); i++ {
+		if skip := w.steps[i].skip; skip == nil || !skip(fd) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (w *Wizard) next(g *gocui.Gui, v *gocui.View) error {
+	if valid, err := w.steps[w.current].ValidateErr(); !valid {
+		return err
+	}
+
+	next := w.nextStep(w.current, w.GetFormData())
+	if next < 0 {
+		return w.finish()
+	}
+
+	w.history = append(w.history, w.current)
+	return w.show(next)
+}
+
+func (w *Wizard) back(g *gocui.Gui, v *gocui.View) error {
+	if len(w.history) == 0 {
+		return nil
+	}
+
+	prev := w.history[len(w.history)-1]
+	w.history = w.history[:len(w.history)-1]
+
+	return w.show(prev)
+}
+
+func (w *Wizard) finish() error {
+	fd := w.GetFormData()
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	if w.onFinish != nil {
+		return w.onFinish(fd)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package component
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/jroimartin/gocui"
+)
+
+func TestWizardNoSteps(t *testing.T) {
+	w := NewWizard(&gocui.Gui{}, "setup", 0, 0, 40, 0)
+
+	if got := w.GetFormData().Len(); got != 0 {
+		t.Errorf("GetFormData() has %d fields", got)
+	}
+	if err := w.Draw(); !errors.Is(err, ErrNoSteps) {
+		t.Errorf("Draw() error = %v, want ErrNoSteps", err)
+	}
+}
+
+func TestWizardGetFormData(t *testing.T) {
+	w := NewWizard(&gocui.Gui{}, "setup", 0, 0, 40, 0)
+
+	account := w.AddStep("Account")
+	account.AddInputField("Name", 5, 10).SetText("gorilla")
+
+	team := w.AddStep("Team")
+	team.AddInputField("Name", 5, 10).SetText("zoo")
+
+	w.history = []int{0}
+	w.current = 1
+
+	fd := w.GetFormData()
+	if got, want := fd.Labels(), []string{"Account.Name", "Team.Name"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Labels() = %v, want %v", got, want)
+	}
+	if got := fd.GetFieldText("Account.Name"); got != "gorilla" {
+		t.Errorf("Account.Name = %q", got)
+	}
+}
+
+func TestWizardDuplicateTitles(t *testing.T) {
+	w := NewWizard(&gocui.Gui{}, "setup", 0, 0, 40, 0)
+
+	home := w.AddStep("Address")
+	home.AddInputField("City", 5, 10).SetText("Tokyo")
+
+	work := w.AddStep("Address")
+	work.AddInputField("City", 5, 10).SetText("Osaka")
+
+	if home.name == work.name {
+		t.Fatalf("steps have the same name %q", home.name)
+	}
+
+	w.history = []int{0}
+	w.current = 1
+
+	fd := w.GetFormData()
+	if got, want := fd.Labels(), []string{"Address.City", "Address#2.City"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Labels() = %v, want %v", got, want)
+	}
+	if got := fd.GetFieldText("Address#2.City"); got != "Osaka" {
+		t.Errorf("Address#2.City = %q", got)
+	}
+}